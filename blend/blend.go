@@ -21,11 +21,9 @@ func Harmonize(designColor color.ARGB, sourceColor color.ARGB) color.ARGB {
 // HctHue blends the hue of from towards the hue of to in HCT color space. The
 // chroma and tone of from are preserved. amount must be between 0.0 and 1.0.
 func HctHue(from color.ARGB, to color.ARGB, amount float64) color.ARGB {
-	ucs := Cam16Ucs(from, to, amount)
-	ucsCam := ucs.ToHct()
-	fromCam := from.ToHct()
-	blended := color.NewHct(ucsCam.Hue, fromCam.Chroma, from.ToCam().J)
-	return blended.ToARGB()
+	ucs := Cam16Ucs(from, to, amount).ToHct()
+	fromHct := from.ToHct()
+	return color.NewHct(ucs.Hue, fromHct.Chroma, fromHct.Tone).ToARGB()
 }
 
 // Cam16Ucs blends from towards to in CAM16-UCS color space. Hue, chroma, and
@@ -43,5 +41,5 @@ func Cam16Ucs(from color.ARGB, to color.ARGB, amount float64) color.ARGB {
 	jstar := fromJ + (toJ-fromJ)*amount
 	astar := fromA + (toA-fromA)*amount
 	bstar := fromB + (toB-fromB)*amount
-	return color.Cam16FromUcs(jstar, astar, bstar).ToARGB()
+	return color.Cam16FromUcs(jstar, astar, bstar, &color.DefaultEnvironment).ToARGB()
 }
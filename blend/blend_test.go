@@ -1,9 +1,11 @@
 package blend
 
 import (
+	"math"
 	"testing"
 
 	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/num"
 )
 
 func TestHarmonize(t *testing.T) {
@@ -42,3 +44,93 @@ func TestHarmonize(t *testing.T) {
 		})
 	}
 }
+
+func TestHarmonize_MaxRotation(t *testing.T) {
+	design := color.ARGB(0xffff0000)
+	for _, source := range []color.ARGB{0xff0000ff, 0xff00ff00, 0xffffff00, 0xffff0000} {
+		from, to := design.ToHct(), source.ToHct()
+		got := Harmonize(design, source).ToHct()
+
+		want := min(num.DifferenceDegrees(from.Hue, to.Hue)*0.5, 15)
+		if diff := num.DifferenceDegrees(from.Hue, got.Hue); diff > want+1 {
+			t.Errorf("Harmonize(%s, %s) rotated %v degrees, want <= %v",
+				design.HexRGB(), source.HexRGB(), diff, want)
+		}
+	}
+
+	if got := Harmonize(design, design); got != design {
+		t.Errorf("Harmonize(c, c) = %s, want %s", got.HexRGB(), design.HexRGB())
+	}
+}
+
+func TestHctHue(t *testing.T) {
+	from := color.ARGB(0xff6750a4)
+	to := color.ARGB(0xff386a20)
+	fromHct, toHct := from.ToHct(), to.ToHct()
+
+	if got := HctHue(from, to, 0); got != from {
+		t.Errorf("HctHue(amount=0) = %s, want %s", got.HexRGB(), from.HexRGB())
+	}
+
+	for _, amount := range []float64{0.25, 0.5, 0.75, 1} {
+		got := HctHue(from, to, amount).ToHct()
+		if math.Abs(got.Tone-fromHct.Tone) > 0.5 {
+			t.Errorf("HctHue(amount=%v) tone = %v, want %v", amount, got.Tone, fromHct.Tone)
+		}
+		// Chroma is kept unless it is out of gamut for the blended hue
+		if got.Chroma > fromHct.Chroma+1 {
+			t.Errorf("HctHue(amount=%v) chroma = %v, want <= %v", amount, got.Chroma, fromHct.Chroma)
+		}
+	}
+
+	if got := HctHue(from, to, 1).ToHct(); num.DifferenceDegrees(got.Hue, toHct.Hue) > 2 {
+		t.Errorf("HctHue(amount=1) hue = %v, want %v", got.Hue, toHct.Hue)
+	}
+}
+
+func TestCam16Ucs(t *testing.T) {
+	from := color.ARGB(0xffff0000)
+	to := color.ARGB(0xff0000ff)
+
+	if got := Cam16Ucs(from, to, 0); got != from {
+		t.Errorf("Cam16Ucs(amount=0) = %s, want %s", got.HexRGB(), from.HexRGB())
+	}
+	if got := Cam16Ucs(from, to, 1); got != to {
+		t.Errorf("Cam16Ucs(amount=1) = %s, want %s", got.HexRGB(), to.HexRGB())
+	}
+
+	// Blending moves monotonically through CAM16-UCS
+	prev := 0.0
+	for _, amount := range []float64{0.25, 0.5, 0.75} {
+		d := from.ToCam().Distance(Cam16Ucs(from, to, amount).ToCam())
+		if d <= prev {
+			t.Errorf("Cam16Ucs(amount=%v) distance = %v, want > %v", amount, d, prev)
+		}
+		prev = d
+	}
+}
+
+func TestSurfaceAtElevation(t *testing.T) {
+	surface := color.ARGB(0xFFFFFBFF)
+	primary := color.ARGB(0xFF6750A4)
+
+	if got := SurfaceAtElevation(surface, primary, 0); got != surface {
+		t.Errorf("SurfaceAtElevation(0) = %s, want %s", got.HexRGB(), surface.HexRGB())
+	}
+
+	// Material 3 elevation levels in dp with their tint opacity
+	levels := []struct{ dp, alpha float64 }{{1, 0.05}, {3, 0.08}, {6, 0.11}, {8, 0.12}, {12, 0.14}}
+	prev := surface.ToHct().Chroma
+	for _, level := range levels {
+		if alpha := ElevationTintAlpha(level.dp); math.Abs(alpha-level.alpha) > 0.005 {
+			t.Errorf("ElevationTintAlpha(%v) = %v, want %v", level.dp, alpha, level.alpha)
+		}
+
+		// Higher surfaces get more tint
+		chroma := SurfaceAtElevation(surface, primary, level.dp).ToHct().Chroma
+		if chroma <= prev {
+			t.Errorf("SurfaceAtElevation(%v) chroma = %v, want > %v", level.dp, chroma, prev)
+		}
+		prev = chroma
+	}
+}
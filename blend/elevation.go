@@ -0,0 +1,30 @@
+package blend
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/color"
+)
+
+// ElevationTintAlpha returns the opacity of the tint color overlaid on a
+// surface at elevation in dp. It follows the Material 3 tonal elevation
+// formula, alpha = (4.5 * ln(elevation + 1) + 2) / 100. Elevation 0 or below
+// has no tint.
+func ElevationTintAlpha(elevation float64) float64 {
+	if elevation <= 0 {
+		return 0
+	}
+	return (4.5*math.Log(elevation+1) + 2) / 100
+}
+
+// SurfaceAtElevation returns surface tinted with tint as it appears at
+// elevation in dp. tint is usually the primary color, or surface_tint role of
+// a scheme. The tint is composited over surface in linear light.
+func SurfaceAtElevation(surface, tint color.ARGB, elevation float64) color.ARGB {
+	alpha := ElevationTintAlpha(elevation)
+	if alpha == 0 {
+		return surface
+	}
+	mixed := color.LinRGBFromARGB(surface).Mix(color.LinRGBFromARGB(tint), alpha)
+	return mixed.ToARGB()
+}
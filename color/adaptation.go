@@ -0,0 +1,97 @@
+package color
+
+import (
+	"errors"
+
+	"github.com/Nadim147c/material/num"
+)
+
+// BradfordMatrix is the cone response matrix of Bradford chromatic adaptation.
+var BradfordMatrix = num.NewMatrix3(
+	0.8951, 0.2664, -0.1614,
+	-0.7502, 1.7135, 0.0367,
+	0.0389, -0.0685, 1.0296,
+)
+
+// CAT is a chromatic adaptation transform. It converts colors seen under one
+// illuminant to the colors that look the same under another illuminant by
+// scaling cone responses.
+type CAT struct {
+	// Name is a human readable name of the transform
+	Name string
+
+	toCone   num.Matrix3 // XYZ to cone responses
+	fromCone num.Matrix3
+}
+
+// ErrSingularCAT is returned when the cone response matrix of a CAT can not be
+// inverted.
+var ErrSingularCAT = errors.New("cone response matrix is not invertible")
+
+// NewCAT creates a CAT from the matrix converting XYZ to cone responses.
+func NewCAT(name string, toCone num.Matrix3) (CAT, error) {
+	fromCone, ok := toCone.Inverse()
+	if !ok {
+		return CAT{}, ErrSingularCAT
+	}
+	return CAT{Name: name, toCone: toCone, fromCone: fromCone}, nil
+}
+
+// mustCAT is like NewCAT but panics on error.
+func mustCAT(name string, toCone num.Matrix3) CAT {
+	cat, err := NewCAT(name, toCone)
+	if err != nil {
+		panic(err)
+	}
+	return cat
+}
+
+var (
+	// Bradford is the transform used by ICC profiles and by this package.
+	Bradford = mustCAT("bradford", BradfordMatrix)
+	// VonKries uses the Hunt-Pointer-Estevez cone responses.
+	VonKries = mustCAT("von-kries", num.NewMatrix3(
+		0.40024, 0.70760, -0.08081,
+		-0.22630, 1.16532, 0.04570,
+		0, 0, 0.91822,
+	))
+	// CAT02 is the transform of CIECAM02.
+	CAT02 = mustCAT("cat02", num.NewMatrix3(
+		0.7328, 0.4296, -0.1624,
+		-0.7036, 1.6975, 0.0061,
+		0.0030, 0.0136, 0.9834,
+	))
+	// CAT16 is the transform of CAM16.
+	CAT16 = mustCAT("cat16", Cat16Matrix)
+	// XYZScaling scales X, Y and Z directly. It is the least accurate
+	// transform.
+	XYZScaling = mustCAT("xyz-scaling", num.NewMatrix3(1, 0, 0, 0, 1, 0, 0, 0, 1))
+)
+
+// ConeMatrix returns the matrix converting XYZ to cone responses of cat.
+func (cat CAT) ConeMatrix() num.Matrix3 {
+	return cat.toCone
+}
+
+// AdaptationMatrix returns a matrix that adapts XYZ colors seen under white
+// point from to white point to.
+func (cat CAT) AdaptationMatrix(from, to XYZ) num.Matrix3 {
+	src := cat.toCone.MultiplyXYZ(from.Values())
+	dst := cat.toCone.MultiplyXYZ(to.Values())
+	scale := num.NewMatrix3(
+		dst[0]/src[0], 0, 0,
+		0, dst[1]/src[1], 0,
+		0, 0, dst[2]/src[2],
+	)
+	return cat.fromCone.MultiplyMatrix(scale).MultiplyMatrix(cat.toCone)
+}
+
+// Adapt converts c seen under white point from to the color that looks the
+// same under white point to using cat. For example, D50 referred values of an
+// ICC profile are converted to D65 referred XYZ used by this package with
+//
+//	xyz.Adapt(IlluminantD50, IlluminantD65, Bradford)
+func (c XYZ) Adapt(from, to XYZ, cat CAT) XYZ {
+	x, y, z := cat.AdaptationMatrix(from, to).MultiplyXYZ(c.Values()).Values()
+	return XYZ{x, y, z}
+}
@@ -0,0 +1,60 @@
+package color
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Nadim147c/material/num"
+)
+
+func TestCAT_AdaptationMatrix(t *testing.T) {
+	d50 := XYZ{96.422, 100, 82.521}
+	d65 := XYZ{95.047, 100, 108.883}
+
+	// Reference matrix from Bruce Lindbloom
+	want := num.NewMatrix3(
+		0.9555766, -0.0230393, 0.0631636,
+		-0.0282895, 1.0099416, 0.0210077,
+		0.0122982, -0.0204830, 1.3299098,
+	)
+	got := Bradford.AdaptationMatrix(d50, d65)
+	for i := range 3 {
+		for j := range 3 {
+			if !almostEqual(got[i][j], want[i][j]) {
+				t.Fatalf("Bradford D50 to D65 = %v, want %v", got, want)
+			}
+		}
+	}
+}
+
+func TestXYZ_Adapt(t *testing.T) {
+	d50 := IlluminantD50
+	a := ChromaticityA.ToXYZ(100)
+
+	for _, cat := range []CAT{Bradford, VonKries, CAT02, CAT16, XYZScaling} {
+		t.Run(cat.Name, func(t *testing.T) {
+			// White maps to white
+			if got := d50.Adapt(d50, a, cat); !almostEqual(got.X, a.X) ||
+				!almostEqual(got.Y, a.Y) || !almostEqual(got.Z, a.Z) {
+				t.Errorf("D50 adapted to A = %v, want %v", got, a)
+			}
+
+			// Adapting back restores the color
+			xyz := ARGB(0xFF3366CC).ToXYZ()
+			got := xyz.Adapt(d50, a, cat).Adapt(a, d50, cat)
+			if !almostEqual(got.X, xyz.X) || !almostEqual(got.Y, xyz.Y) || !almostEqual(got.Z, xyz.Z) {
+				t.Errorf("round trip = %v, want %v", got, xyz)
+			}
+		})
+	}
+}
+
+func TestNewCAT(t *testing.T) {
+	if _, err := NewCAT("singular", num.Matrix3{}); !errors.Is(err, ErrSingularCAT) {
+		t.Errorf("NewCAT(zero) error = %v, want ErrSingularCAT", err)
+	}
+	cat, err := NewCAT("bradford", BradfordMatrix)
+	if err != nil || cat.ConeMatrix() != BradfordMatrix {
+		t.Errorf("NewCAT(BradfordMatrix) = %v, %v", cat, err)
+	}
+}
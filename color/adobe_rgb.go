@@ -0,0 +1,59 @@
+package color
+
+// AdobeRGB is a color in Adobe RGB (1998) color space with D65 white point
+// and a pure 563/256 gamma. R, G, B are gamma encoded and in range [0, 1] for
+// colors inside the Adobe RGB gamut.
+type AdobeRGB struct {
+	R, G, B float64
+}
+
+var _ digitalColor = (*AdobeRGB)(nil)
+
+// NewAdobeRGB creates an Adobe RGB color from gamma encoded components.
+func NewAdobeRGB(r, g, b float64) AdobeRGB {
+	return AdobeRGB{r, g, b}
+}
+
+// AdobeRGBFromXYZ converts a XYZ color to Adobe RGB.
+func AdobeRGBFromXYZ(xyz XYZ) AdobeRGB {
+	r, g, b := AdobeRGBSpace.FromXYZ(xyz)
+	return AdobeRGB{r, g, b}
+}
+
+// AdobeRGBFromARGB converts a sRGB color to Adobe RGB.
+func AdobeRGBFromARGB(c ARGB) AdobeRGB {
+	return AdobeRGBFromXYZ(c.ToXYZ())
+}
+
+// Values returns r, g, b values of AdobeRGB color
+func (c AdobeRGB) Values() (float64, float64, float64) {
+	return c.R, c.G, c.B
+}
+
+// ToXYZ returns XYZ color version for c.
+func (c AdobeRGB) ToXYZ() XYZ {
+	return AdobeRGBSpace.ToXYZ(c.R, c.G, c.B)
+}
+
+// ToARGB converts c to sRGB. Colors outside of sRGB gamut are clipped.
+func (c AdobeRGB) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c AdobeRGB) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c AdobeRGB) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c AdobeRGB) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c AdobeRGB) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
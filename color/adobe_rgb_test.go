@@ -0,0 +1,16 @@
+package color
+
+import "testing"
+
+func TestAdobeRGB_FromARGB(t *testing.T) {
+	testFromARGB(t, AdobeRGBFromARGB, sameValues, []fromARGBCase[AdobeRGB]{
+		{"White", 0xFFFFFFFF, AdobeRGB{1, 1, 1}},
+		{"Black", 0xFF000000, AdobeRGB{0, 0, 0}},
+		{"Red", 0xFFFF0000, AdobeRGB{0.8585, 0, 0}},
+		{"Green", 0xFF00FF00, AdobeRGB{0.5650, 1, 0.2343}},
+	})
+}
+
+func TestAdobeRGB_RoundTrip(t *testing.T) {
+	testRoundTrip(t, AdobeRGBFromARGB)
+}
@@ -4,9 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"image/color"
-	"regexp"
-	"strconv"
-	"strings"
 )
 
 // Offset indiacates bit offset of Components in ARGB
@@ -35,7 +32,19 @@ func NewARGB(a, r, g, b uint8) ARGB {
 		ARGB(b)<<blueOffset
 }
 
-// ARGBFromInterface
+// ARGBFromUint32 creates a ARGB from a packed 0xAARRGGBB integer, as used by
+// Android color resources and protobuf messages.
+func ARGBFromUint32(v uint32) ARGB {
+	return ARGB(v)
+}
+
+// Uint32 returns the color packed as 0xAARRGGBB integer.
+func (c ARGB) Uint32() uint32 {
+	return uint32(c)
+}
+
+// ARGBFromInterface converts a color.Color to ARGB assuming the color is 8-bit
+// sRGB. Use ARGBFromInterfaceIn or HctFromInterface for high bit depth colors.
 func ARGBFromInterface(color color.Color) ARGB {
 	r16, g16, b16, a16 := color.RGBA()
 
@@ -48,6 +57,50 @@ func ARGBFromInterface(color color.Color) ARGB {
 	return NewARGB(a8, r8, g8, b8)
 }
 
+// unpremultiplied returns the non-premultiplied components of c normalized to
+// [0, 1].
+func unpremultiplied(c color.Color) (r, g, b, a float64) {
+	r16, g16, b16, a16 := c.RGBA()
+	if a16 == 0 {
+		return 0, 0, 0, 0
+	}
+	a = float64(a16)
+	return float64(r16) / a, float64(g16) / a, float64(b16) / a, a / 0xFFFF
+}
+
+// XYZFromInterface converts a color.Color to XYZ using full 16-bit precision
+// of the color. Components are interpreted as gamma encoded in space, a nil
+// space means SRGBSpace.
+func XYZFromInterface(c color.Color, space *RGBColorSpace) XYZ {
+	if space == nil {
+		space = SRGBSpace
+	}
+	r, g, b, _ := unpremultiplied(c)
+	return space.ToXYZ(r, g, b)
+}
+
+// HctFromInterface converts a color.Color to Hct without truncating it to 8
+// bits first. See XYZFromInterface.
+func HctFromInterface(c color.Color, space *RGBColorSpace) Hct {
+	xyz := XYZFromInterface(c, space)
+	cam := xyz.ToCam()
+	return Hct{cam.Hue, cam.Chroma, xyz.LStar()}
+}
+
+// ARGBFromInterfaceIn converts a color.Color with components in space to sRGB
+// ARGB. Components are rounded instead of truncated and colors outside of sRGB
+// gamut are clipped. A nil space means SRGBSpace.
+func ARGBFromInterfaceIn(c color.Color, space *RGBColorSpace) ARGB {
+	if space == nil {
+		space = SRGBSpace
+	}
+	r, g, b, a := unpremultiplied(c)
+	if space != SRGBSpace {
+		r, g, b = SRGBSpace.FromXYZ(space.ToXYZ(r, g, b))
+	}
+	return NewARGB(to8Bit(a), to8Bit(r), to8Bit(g), to8Bit(b))
+}
+
 // Converts an L* value to an ARGB representation. lstar is L* in L*a*b*.
 // returns ARGB representation of grayscale color with lightness matching L*
 func ARGBFromLstar(lstar float64) ARGB {
@@ -76,7 +129,7 @@ func ARGBFromLinRGB(r, g, b float64) ARGB {
 
 // ToCam16 convert ARGB Color to Cam16
 func (c ARGB) ToCam() *Cam16 {
-	return Cam16FromXyzInEnv(c.ToXYZ(), &DefaultEnviroment)
+	return Cam16FromXyzInEnv(c.ToXYZ(), &DefaultEnvironment)
 }
 
 // ToHct convert ARGB Color to Hct
@@ -142,7 +195,18 @@ func (c ARGB) AnsiBg(text string) string {
 	return fmt.Sprintf("\x1b[48;2;%d;%d;%dm%s\x1b[0m", r, g, b, text)
 }
 
+// String returns hex representation of the color. Depending on StringStyle a
+// ANSI color swatch is appended, see SetStringStyle.
 func (c ARGB) String() string {
+	if GetStringStyle() == PlainHex {
+		return c.HexRGB()
+	}
+	return c.Swatch()
+}
+
+// Swatch returns hex representation of the color followed by a ANSI colored
+// swatch, regardless of StringStyle.
+func (c ARGB) Swatch() string {
 	return c.HexRGB() + " " + c.AnsiBg("  ")
 }
 
@@ -151,9 +215,9 @@ func (c ARGB) MarshalText() ([]byte, error) {
 	return []byte(c.HexRGBA()), nil
 }
 
-// TextUnmarshaler
+// TextUnmarshaler. It accepts every color string supported by Parse.
 func (c *ARGB) UnmarshalText(text []byte) error {
-	argb, err := ARGBFromHex(string(text))
+	argb, err := Parse(string(text))
 	if err != nil {
 		return err
 	}
@@ -206,56 +270,71 @@ func ARGBFromHexMust(hex string) ARGB {
 	return color
 }
 
+// ErrInvalidLength is returned by ARGBFromHex when the number of hex digits is
+// not 3, 4, 6 or 8.
+var ErrInvalidLength = errors.New("invalid hex color length")
+
+// ErrInvalidDigit is returned by ARGBFromHex when input contains a character
+// that is not a hex digit.
+type ErrInvalidDigit struct {
+	// Pos is the byte offset of the invalid character in the input
+	Pos int
+	// Char is the invalid character
+	Char byte
+}
+
+func (e ErrInvalidDigit) Error() string {
+	return fmt.Sprintf("invalid hex digit %q at position %d", e.Char, e.Pos)
+}
+
+// hexDigit returns value of a hex digit and whether c is a valid hex digit.
+func hexDigit(c byte) (uint32, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return uint32(c - '0'), true
+	case c >= 'a' && c <= 'f':
+		return uint32(c - 'a' + 10), true
+	case c >= 'A' && c <= 'F':
+		return uint32(c - 'A' + 10), true
+	}
+	return 0, false
+}
+
 // ARGBFromHex parses a hex color string and returns a Color.
 // Supports formats: #RGB, #RGBA, #RRGGBB, #RRGGBBAA
+//
+// The leading # is optional. Parsing does not allocate.
+//
+// Errors are either ErrInvalidLength or ErrInvalidDigit.
 func ARGBFromHex(hex string) (ARGB, error) {
-	hex = strings.TrimPrefix(hex, "#")
-
-	// Regex check if input is valid or not
-	hexColorRegex := regexp.MustCompile(`^#?([0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
-	if !hexColorRegex.MatchString(hex) {
-		return 0, errors.New("invalid hex color format")
+	offset := 0
+	if len(hex) > 0 && hex[0] == '#' {
+		hex = hex[1:]
+		offset = 1
 	}
 
-	// Expand shorthand formats to full 6/8-char form
-	switch len(hex) {
-	case 3: // #RGB → #RRGGBB
-		hex = fmt.Sprintf("%c%c%c%c%c%c",
-			hex[0], hex[0],
-			hex[1], hex[1],
-			hex[2], hex[2],
-		)
-	case 4: // #RGBA → #RRGGBBAA
-		hex = fmt.Sprintf("%c%c%c%c%c%c%c%c",
-			hex[0], hex[0],
-			hex[1], hex[1],
-			hex[2], hex[2],
-			hex[3], hex[3],
-		)
+	n := len(hex)
+	if n != 3 && n != 4 && n != 6 && n != 8 {
+		return 0, ErrInvalidLength
 	}
 
-	var r, g, b, a uint8 = 0, 0, 0, 0xFF
-
-	switch len(hex) {
-	case 6:
-		val, err := strconv.ParseUint(hex, 16, 24)
-		if err != nil {
-			return 0, fmt.Errorf("invalid hex color: %w", err)
+	var val uint32
+	for i := range n {
+		d, ok := hexDigit(hex[i])
+		if !ok {
+			return 0, ErrInvalidDigit{Pos: i + offset, Char: hex[i]}
 		}
-		r = uint8(val >> 16)
-		g = uint8((val >> 8) & 0xFF)
-		b = uint8(val & 0xFF)
-
-	case 8:
-		val, err := strconv.ParseUint(hex, 16, 32)
-		if err != nil {
-			return 0, fmt.Errorf("invalid hex color: %w", err)
+		if n <= 4 {
+			// Shorthand digit is repeated: F → FF
+			val = val<<8 | d<<4 | d
+		} else {
+			val = val<<4 | d
 		}
-		r = uint8(val >> 24)
-		g = uint8((val >> 16) & 0xFF)
-		b = uint8((val >> 8) & 0xFF)
-		a = uint8(val & 0xFF)
 	}
 
-	return NewARGB(a, r, g, b), nil
+	// Formats without alpha are opaque, formats with alpha are RGBA
+	if n == 3 || n == 6 {
+		return ARGB(0xFF000000 | val), nil
+	}
+	return ARGB(val>>8 | val<<24), nil
 }
@@ -1,6 +1,10 @@
 package color
 
-import "testing"
+import (
+	"errors"
+	"image/color"
+	"testing"
+)
 
 func TestColor_ToXYZ(t *testing.T) {
 	for _, tt := range ColorTestCases {
@@ -102,6 +106,34 @@ func TestFromHex(t *testing.T) {
 			name: "Invalid characters",
 			hex:  "#GGGGGG", want: ARGB(0), wantErr: true,
 		},
+		{
+			name: "3-digit hex",
+			hex:  "#F0A", want: ARGB(0xFFFF00AA), wantErr: false,
+		},
+		{
+			name: "4-digit hex",
+			hex:  "#F0A8", want: ARGB(0x88FF00AA), wantErr: false,
+		},
+		{
+			name: "6-digit hex without #",
+			hex:  "1a2B3c", want: ARGB(0xFF1A2B3C), wantErr: false,
+		},
+		{
+			name: "8-digit hex",
+			hex:  "#11223344", want: ARGB(0x44112233), wantErr: false,
+		},
+		{
+			name: "Invalid length",
+			hex:  "#12345", want: ARGB(0), wantErr: true,
+		},
+		{
+			name: "Empty",
+			hex:  "", want: ARGB(0), wantErr: true,
+		},
+		{
+			name: "Double hash",
+			hex:  "##123456", want: ARGB(0), wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -118,6 +150,44 @@ func TestFromHex(t *testing.T) {
 	}
 }
 
+func TestFromHex_Errors(t *testing.T) {
+	if _, err := ARGBFromHex("#12345"); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("ARGBFromHex(#12345) error = %v, want %v", err, ErrInvalidLength)
+	}
+
+	tests := []struct {
+		hex  string
+		want ErrInvalidDigit
+	}{
+		{"#12G456", ErrInvalidDigit{Pos: 3, Char: 'G'}},
+		{"12345z", ErrInvalidDigit{Pos: 5, Char: 'z'}},
+		{"##123", ErrInvalidDigit{Pos: 1, Char: '#'}},
+	}
+	for _, tt := range tests {
+		_, err := ARGBFromHex(tt.hex)
+		var digitErr ErrInvalidDigit
+		if !errors.As(err, &digitErr) || digitErr != tt.want {
+			t.Errorf("ARGBFromHex(%q) error = %v, want %v", tt.hex, err, tt.want)
+		}
+	}
+
+	// Errors are kept when wrapped by Parse
+	_, err := Parse("#12G456")
+	if !errors.As(err, new(ErrInvalidDigit)) {
+		t.Errorf("Parse(#12G456) error = %v, want ErrInvalidDigit", err)
+	}
+}
+
+func TestFromHex_Allocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = ARGBFromHex("#1A2B3C4D")
+		_, _ = ARGBFromHex("abc")
+	})
+	if allocs != 0 {
+		t.Errorf("ARGBFromHex() allocates %v times, want 0", allocs)
+	}
+}
+
 func TestColor_HexRGB(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -177,3 +247,38 @@ func TestColor_HexRGBA(t *testing.T) {
 		})
 	}
 }
+
+func TestARGBFromInterfaceIn(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			if got := ARGBFromInterfaceIn(tt.ARGB, nil); got != tt.ARGB {
+				t.Errorf("ARGBFromInterfaceIn(%s) = %s", tt.ARGB.HexARGB(), got.HexARGB())
+			}
+			if got := HctFromInterface(tt.ARGB, nil).ToARGB(); got != tt.ARGB {
+				t.Errorf("HctFromInterface(%s) = %s", tt.ARGB.HexARGB(), got.HexARGB())
+			}
+		})
+	}
+
+	// 16-bit values between two 8-bit steps must keep their precision
+	dark := color.Gray16{Y: 0x8000}
+	light := color.Gray16{Y: 0x8060}
+	if ARGBFromInterface(dark) != ARGBFromInterface(light) {
+		t.Fatalf("ARGBFromInterface() should truncate %v and %v to same color", dark, light)
+	}
+	if HctFromInterface(dark, nil).Tone >= HctFromInterface(light, nil).Tone {
+		t.Errorf("HctFromInterface(%v) must be darker than HctFromInterface(%v)", dark, light)
+	}
+
+	// Premultiplied alpha is undone
+	half := color.NRGBA{R: 200, G: 100, B: 50, A: 128}
+	if got, want := ARGBFromInterfaceIn(half, nil), NewARGB(128, 200, 100, 50); got != want {
+		t.Errorf("ARGBFromInterfaceIn(%v) = %s, want %s", half, got.HexARGB(), want.HexARGB())
+	}
+
+	// Display P3 red is clipped to sRGB red
+	p3 := color.RGBA64{R: 0xFFFF, A: 0xFFFF}
+	if got := ARGBFromInterfaceIn(p3, DisplayP3Space); got != 0xFFFF0000 {
+		t.Errorf("ARGBFromInterfaceIn(P3 red) = %s, want #FFFF0000", got.HexARGB())
+	}
+}
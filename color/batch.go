@@ -0,0 +1,73 @@
+package color
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// batchChunk is the number of colors a worker converts at a time.
+const batchChunk = 2048
+
+// convertSlice converts src with convert on a pool of GOMAXPROCS workers.
+// Workers take chunks of batchChunk colors, so the work stays balanced when
+// some colors are slower to convert than others.
+func convertSlice[S, D any](src []S, convert func(dst []D, src []S)) []D {
+	dst := make([]D, len(src))
+	chunks := (len(src) + batchChunk - 1) / batchChunk
+	workers := min(runtime.GOMAXPROCS(0), chunks)
+	if workers <= 1 {
+		convert(dst, src)
+		return dst
+	}
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			for {
+				chunk := int(next.Add(1)) - 1
+				if chunk >= chunks {
+					return
+				}
+				start := chunk * batchChunk
+				end := min(start+batchChunk, len(src))
+				convert(dst[start:end], src[start:end])
+			}
+		}()
+	}
+	wg.Wait()
+	return dst
+}
+
+// ARGBToHctSlice converts colors to Hct in parallel. The result is identical
+// to calling ToHct on every color. Runs of the same color, as common in image
+// pixels, are converted once.
+func ARGBToHctSlice(colors []ARGB) []Hct {
+	return convertSlice(colors, func(dst []Hct, src []ARGB) {
+		for i, c := range src {
+			if i > 0 && c == src[i-1] {
+				dst[i] = dst[i-1]
+				continue
+			}
+			dst[i] = c.ToHct()
+		}
+	})
+}
+
+// HctToARGBSlice converts colors to ARGB in parallel. The result is identical
+// to calling ToARGB on every color, including use of the cache set with
+// SetSolverCache.
+func HctToARGBSlice(colors []Hct) []ARGB {
+	return convertSlice(colors, func(dst []ARGB, src []Hct) {
+		for i, h := range src {
+			if i > 0 && h == src[i-1] {
+				dst[i] = dst[i-1]
+				continue
+			}
+			dst[i] = h.ToARGB()
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package color
+
+import "testing"
+
+func TestBatchConversion(t *testing.T) {
+	// Large enough to be split between workers, with runs of the same color
+	colors := make([]ARGB, 3*batchChunk+17)
+	for i := range colors {
+		colors[i] = ARGB(0xFF000000 | uint32(i/3)*0x10307)
+	}
+
+	hcts := ARGBToHctSlice(colors)
+	if len(hcts) != len(colors) {
+		t.Fatalf("ARGBToHctSlice() returned %d colors, want %d", len(hcts), len(colors))
+	}
+	for i, c := range colors {
+		if hcts[i] != c.ToHct() {
+			t.Fatalf("ARGBToHctSlice()[%d] = %v, want %v", i, hcts[i], c.ToHct())
+		}
+	}
+
+	back := HctToARGBSlice(hcts)
+	for i, h := range hcts {
+		if back[i] != h.ToARGB() {
+			t.Fatalf("HctToARGBSlice()[%d] = %s, want %s", i, back[i].HexRGB(), h.ToARGB().HexRGB())
+		}
+	}
+
+	if got := ARGBToHctSlice(nil); len(got) != 0 {
+		t.Errorf("ARGBToHctSlice(nil) = %v, want empty", got)
+	}
+}
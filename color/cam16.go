@@ -54,10 +54,14 @@ func NewCam16(hue, chroma, j, q, m, s, jstar, astar, bstar float64) *Cam16 {
 	return &Cam16{hue, chroma, j, q, m, s, jstar, astar, bstar}
 }
 
-// Cam16FromColorInEnv create a Cam16 color In specific ViewingConditions
-func Cam16FromXyzInEnv(xyz XYZ, env *Environmnet) *Cam16 {
+// Cam16FromXyzInEnv creates a Cam16 color from D65 referred xyz seen in env.
+// If env has a white point other than D65, xyz is adapted to it first.
+func Cam16FromXyzInEnv(xyz XYZ, env *Environment) *Cam16 {
 	// Get XYZ color model
 	x, y, z := xyz.Values()
+	if env.adapt {
+		x, y, z = env.fromD65.MultiplyXYZ(x, y, z).Values()
+	}
 
 	// Convert XYZ to 'cone'/'rgb' responses
 	rC, gC, bC := Cat16Matrix.MultiplyXYZ(x, y, z).Values()
@@ -122,7 +126,7 @@ func Cam16FromXyzInEnv(xyz XYZ, env *Environmnet) *Cam16 {
 // This is used when synthesizing a CAM16 color from HCT values or
 // performing color space conversions into perceptual models.
 func Cam16FromJch(j, c, h float64) *Cam16 {
-	return Cam16FromJchInEnv(j, c, h, &DefaultEnviroment)
+	return Cam16FromJchInEnv(j, c, h, &DefaultEnvironment)
 }
 
 // Cam16FromJchInEnv constructs a Cam16 color from J (lightness), C (chroma),
@@ -130,7 +134,7 @@ func Cam16FromJch(j, c, h float64) *Cam16 {
 //
 // This is used when synthesizing a CAM16 color from HCT values or
 // performing color space conversions into perceptual models.
-func Cam16FromJchInEnv(j, c, h float64, env *Environmnet) *Cam16 {
+func Cam16FromJchInEnv(j, c, h float64, env *Environment) *Cam16 {
 	q := (4.0 / env.C) * math.Sqrt(j/100.0) * (env.Aw + 4.0) * env.FlRoot
 	m := c * env.FlRoot
 
@@ -148,9 +152,9 @@ func Cam16FromJchInEnv(j, c, h float64, env *Environmnet) *Cam16 {
 	return NewCam16(h, c, j, q, m, s, jstar, astar, bstar)
 }
 
-// Viewed converts a CAM16 color to an ARGB integer based on
-// the given viewing conditions
-func (c *Cam16) Viewed(vc *Environmnet) XYZ {
+// Viewed converts c seen in vc to D65 referred XYZ. If vc has a white point
+// other than D65, the result is adapted back to D65.
+func (c *Cam16) Viewed(vc *Environment) XYZ {
 	var alpha float64
 	if c.Chroma == 0.0 || c.J == 0.0 {
 		alpha = 0.0
@@ -193,14 +197,16 @@ func (c *Cam16) Viewed(vc *Environmnet) XYZ {
 	bF := bC / vc.RgbD[2]
 
 	x, y, z := Cat16InvMatrix.MultiplyXYZ(rF, gF, bF).Values()
+	if vc.adapt {
+		x, y, z = vc.toD65.MultiplyXYZ(x, y, z).Values()
+	}
 	return XYZ{x, y, z}
 }
 
-func Cam16FromUcs(jstar, astar, bstar float64) *Cam16 {
-	return Cam16FromUcsInEnv(jstar, astar, bstar, &DefaultEnviroment)
-}
-
-func Cam16FromUcsInEnv(jstar, astar, bstar float64, env *Environmnet) *Cam16 {
+// Cam16FromUcs creates a Cam16 color from CAM16-UCS coordinates J*, a* and b*
+// in env. It is the inverse of the Jstar, Astar and Bstar fields, so colors
+// can be manipulated in UCS space and converted back.
+func Cam16FromUcs(jstar, astar, bstar float64, env *Environment) *Cam16 {
 	a := astar
 	b := bstar
 	m := math.Sqrt(a*a + b*b)
@@ -209,23 +215,33 @@ func Cam16FromUcsInEnv(jstar, astar, bstar float64, env *Environmnet) *Cam16 {
 	h := math.Atan2(b, a) * (180.0 / math.Pi)
 	h = num.NormalizeDegree(h)
 	j := jstar / (1 - (jstar-100)*0.007)
-	return Cam16FromUcsInEnv(j, c, h, env)
+	return Cam16FromJchInEnv(j, c, h, env)
 }
 
+// Cam16FromUcsInEnv creates a Cam16 color from CAM16-UCS coordinates in env.
+//
+// Deprecated: Use Cam16FromUcs.
+func Cam16FromUcsInEnv(jstar, astar, bstar float64, env *Environment) *Cam16 {
+	return Cam16FromUcs(jstar, astar, bstar, env)
+}
+
+// ToHct converts c to Hct. Hue and chroma are kept, even outside of the sRGB
+// gamut. Tone of Hct is L*, which differs from lightness J of CAM16, so it is
+// computed from XYZ.
 func (c *Cam16) ToHct() Hct {
-	return NewHct(c.Hue, c.Chroma, c.J)
+	return Hct{c.Hue, c.Chroma, c.ToXYZ().LStar()}
 }
 
 func (c *Cam16) ToXYZ() XYZ {
-	return c.Viewed(&DefaultEnviroment)
+	return c.Viewed(&DefaultEnvironment)
 }
 
 func (c *Cam16) ToLab() Lab {
-	return c.Viewed(&DefaultEnviroment).ToLab()
+	return c.Viewed(&DefaultEnvironment).ToLab()
 }
 
 func (c *Cam16) ToARGB() ARGB {
-	return c.Viewed(&DefaultEnviroment).ToARGB()
+	return c.Viewed(&DefaultEnvironment).ToARGB()
 }
 
 func (c *Cam16) RGBA() (uint32, uint32, uint32, uint32) {
@@ -236,13 +252,12 @@ func (c *Cam16) ToCam() *Cam16 {
 	return c
 }
 
-// Distance returns distance between to Cam16 color
-func (c Cam16) Distance(other Cam16) float64 {
-	dJ := c.Jstar - other.Jstar
-	dA := c.Astar - other.Astar
-	dB := c.Bstar - other.Bstar
+// ToUCS returns the CAM16-UCS coordinates of c.
+func (c *Cam16) ToUCS() Cam16UCS {
+	return Cam16UCS{c.Jstar, c.Astar, c.Bstar}
+}
 
-	dEPrime := math.Sqrt(dJ*dJ + dA*dA + dB*dB)
-	dE := 1.41 * math.Pow(dEPrime, 0.63)
-	return dE
+// Distance returns the color difference ΔE' between c and other in CAM16-UCS.
+func (c *Cam16) Distance(other *Cam16) float64 {
+	return c.ToUCS().Distance(other.ToUCS())
 }
@@ -115,3 +115,24 @@ func TestCam(t *testing.T) {
 		})
 	}
 }
+
+func TestCam16_ToHct(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			got, want := tt.ARGB.ToCam().ToHct(), tt.ARGB.ToHct()
+			if !almostEqual(got.Hue, want.Hue) || !almostEqual(got.Chroma, want.Chroma) ||
+				!almostEqual(got.Tone, want.Tone) {
+				t.Errorf("ToHct() = %v, want %v", got, want)
+			}
+		})
+	}
+
+	// Out of sRGB gamut, hue and chroma are kept
+	cam := Cam16FromJch(50, 150, 140)
+	if got := cam.ToHct(); !almostEqual(got.Hue, 140) || !almostEqual(got.Chroma, 150) {
+		t.Errorf("ToHct() = %v, want hue 140 and chroma 150", got)
+	}
+	if got := cam.ToUCS().ToHct(); !almostEqual(got.Hue, 140) || !almostEqual(got.Chroma, 150) {
+		t.Errorf("Cam16UCS.ToHct() = %v, want hue 140 and chroma 150", got)
+	}
+}
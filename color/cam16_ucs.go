@@ -0,0 +1,75 @@
+package color
+
+import "math"
+
+// Cam16UCS is a color in CAM16-UCS, the uniform color space derived from
+// CAM16. Euclidean distance in CAM16-UCS closely matches perceived color
+// difference, which makes it suitable for clustering and blending.
+type Cam16UCS struct {
+	// Jstar is the lightness coordinate
+	Jstar float64
+	// Astar is the redness-greenness coordinate
+	Astar float64
+	// Bstar is the yellowness-blueness coordinate
+	Bstar float64
+}
+
+var _ digitalColor = (*Cam16UCS)(nil)
+
+// NewCam16UCS creates a CAM16-UCS color from J*, a* and b* coordinates.
+func NewCam16UCS(jstar, astar, bstar float64) Cam16UCS {
+	return Cam16UCS{jstar, astar, bstar}
+}
+
+// Cam16UCSFromARGB returns CAM16-UCS coordinates of c in DefaultEnvironment.
+func Cam16UCSFromARGB(c ARGB) Cam16UCS {
+	return c.ToCam().ToUCS()
+}
+
+// Values returns J*, a*, b* values of Cam16UCS color
+func (u Cam16UCS) Values() (float64, float64, float64) {
+	return u.Jstar, u.Astar, u.Bstar
+}
+
+// Distance returns the color difference ΔE' between u and other.
+func (u Cam16UCS) Distance(other Cam16UCS) float64 {
+	dJ := u.Jstar - other.Jstar
+	dA := u.Astar - other.Astar
+	dB := u.Bstar - other.Bstar
+
+	dEPrime := math.Sqrt(dJ*dJ + dA*dA + dB*dB)
+	dE := 1.41 * math.Pow(dEPrime, 0.63)
+	return dE
+}
+
+// ToCam converts u to Cam16 in DefaultEnvironment.
+func (u Cam16UCS) ToCam() *Cam16 {
+	return Cam16FromUcs(u.Jstar, u.Astar, u.Bstar, &DefaultEnvironment)
+}
+
+// ToCamInEnv converts u to Cam16 in the given viewing conditions.
+func (u Cam16UCS) ToCamInEnv(env *Environment) *Cam16 {
+	return Cam16FromUcs(u.Jstar, u.Astar, u.Bstar, env)
+}
+
+func (u Cam16UCS) ToXYZ() XYZ {
+	return u.ToCam().ToXYZ()
+}
+
+func (u Cam16UCS) ToARGB() ARGB {
+	return u.ToCam().ToARGB()
+}
+
+func (u Cam16UCS) ToLab() Lab {
+	return u.ToCam().ToLab()
+}
+
+func (u Cam16UCS) ToHct() Hct {
+	return u.ToCam().ToHct()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (u Cam16UCS) RGBA() (uint32, uint32, uint32, uint32) {
+	return u.ToARGB().RGBA()
+}
@@ -0,0 +1,46 @@
+package color
+
+import "testing"
+
+func TestCam16UCS_RoundTrip(t *testing.T) {
+	for _, argb := range []ARGB{0xFFFF0000, 0xFF00FF00, 0xFF0000FF, 0xFF808080} {
+		t.Run(argb.HexRGB(), func(t *testing.T) {
+			ucs := Cam16UCSFromARGB(argb)
+			if got := ucs.ToARGB(); got != argb {
+				t.Errorf("Cam16UCS(%s) Round Trip = %s, want %s", argb.HexRGB(), got.HexRGB(), argb.HexRGB())
+			}
+		})
+	}
+}
+
+func TestCam16UCS_Distance(t *testing.T) {
+	red := Cam16UCSFromARGB(0xFFFF0000)
+	blue := Cam16UCSFromARGB(0xFF0000FF)
+
+	if got := red.Distance(red); got != 0 {
+		t.Errorf("Distance(red, red) = %f, want 0", got)
+	}
+
+	d1, d2 := red.Distance(blue), blue.Distance(red)
+	if !almostEqual(d1, d2) {
+		t.Errorf("Distance is not symmetric: %f != %f", d1, d2)
+	}
+
+	cam := ARGB(0xFFFF0000).ToCam()
+	if got := cam.Distance(ARGB(0xFF0000FF).ToCam()); !almostEqual(got, d1) {
+		t.Errorf("Cam16.Distance() = %f, want %f", got, d1)
+	}
+}
+
+func TestCam16FromUcs(t *testing.T) {
+	for _, env := range []Environment{DefaultEnvironment, DarkEnvironment, PrintEnvironment} {
+		for _, tt := range ColorTestCases {
+			cam := Cam16FromXyzInEnv(tt.ARGB.ToXYZ(), &env)
+			got := Cam16FromUcs(cam.Jstar, cam.Astar, cam.Bstar, &env)
+			if !almostEqual(got.J, cam.J) || !almostEqual(got.Chroma, cam.Chroma) ||
+				(cam.Chroma > 0.1 && !almostEqual(got.Hue, cam.Hue)) {
+				t.Errorf("Cam16FromUcs(%s) = %+v, want %+v", tt.ARGB.HexRGB(), got, cam)
+			}
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package color
+
+import "math"
+
+// Chromaticity is a CIE 1931 xy chromaticity coordinate.
+type Chromaticity struct {
+	X, Y float64
+}
+
+// ToXYZ returns the XYZ color with this chromaticity and luminance y.
+func (c Chromaticity) ToXYZ(y float64) XYZ {
+	if c.Y == 0 {
+		return XYZ{0, 0, 0}
+	}
+	return XYZ{c.X * y / c.Y, y, (1 - c.X - c.Y) * y / c.Y}
+}
+
+var (
+	// ChromaticityD65 is the chromaticity of IlluminantD65.
+	ChromaticityD65 = chromaticityOf(IlluminantD65)
+	// ChromaticityD50 is the chromaticity of IlluminantD50.
+	ChromaticityD50 = chromaticityOf(IlluminantD50)
+	// ChromaticityA is the chromaticity of CIE standard illuminant A, a
+	// tungsten lamp of 2856 K.
+	ChromaticityA = Chromaticity{0.44757, 0.40745}
+)
+
+// chromaticityOf returns the xy chromaticity of a white point.
+func chromaticityOf(white XYZ) Chromaticity {
+	sum := white.X + white.Y + white.Z
+	return Chromaticity{white.X / sum, white.Y / sum}
+}
+
+// ChromaticityFromXYZ returns the xy chromaticity of xyz. Black has the
+// chromaticity of D65 white.
+func ChromaticityFromXYZ(xyz XYZ) Chromaticity {
+	sum := xyz.X + xyz.Y + xyz.Z
+	if sum == 0 {
+		return ChromaticityD65
+	}
+	return Chromaticity{xyz.X / sum, xyz.Y / sum}
+}
+
+// cross returns the z component of the cross product (b - a) × (c - a).
+func cross(a, b, c Chromaticity) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+}
+
+// polygonArea returns the area of a simple polygon using the shoelace formula.
+func polygonArea(points []Chromaticity) float64 {
+	area := 0.0
+	for i := range points {
+		a, b := points[i], points[(i+1)%len(points)]
+		area += a.X*b.Y - b.X*a.Y
+	}
+	return math.Abs(area) / 2
+}
+
+// primaries returns the primaries of s in counter clockwise order.
+func (s *RGBColorSpace) primaries() []Chromaticity {
+	if cross(s.Red, s.Green, s.Blue) < 0 {
+		return []Chromaticity{s.Red, s.Blue, s.Green}
+	}
+	return []Chromaticity{s.Red, s.Green, s.Blue}
+}
+
+// ContainsChromaticity reports whether c lies inside, or on the edge of, the
+// triangle formed by primaries of s.
+func (s *RGBColorSpace) ContainsChromaticity(c Chromaticity) bool {
+	const e = 1e-9
+	p := s.primaries()
+	return cross(p[0], p[1], c) >= -e && cross(p[1], p[2], c) >= -e && cross(p[2], p[0], c) >= -e
+}
+
+// ContainsXYZ reports whether the chromaticity of D65 referenced xyz is
+// inside the gamut of s. Luminance is ignored, use FromXYZ to check if the
+// color fits in the RGB cube.
+func (s *RGBColorSpace) ContainsXYZ(xyz XYZ) bool {
+	const e = 1e-9
+	r, g, b := s.LinearFromXYZ(xyz)
+	return r >= -e && g >= -e && b >= -e
+}
+
+// Area returns the area of the gamut triangle of s in xy chromaticity diagram.
+func (s *RGBColorSpace) Area() float64 {
+	return polygonArea(s.primaries())
+}
+
+// GamutCoverage returns the percentage of reference gamut area that is also
+// covered by space in xy chromaticity diagram. For example, coverage of
+// DisplayP3Space over SRGBSpace is 100 and the other way around about 73.7.
+func GamutCoverage(space, reference *RGBColorSpace) float64 {
+	refArea := reference.Area()
+	if refArea == 0 {
+		return 0
+	}
+
+	// Clip reference triangle by every edge of space (Sutherland–Hodgman)
+	polygon := reference.primaries()
+	clip := space.primaries()
+	for i := range clip {
+		a, b := clip[i], clip[(i+1)%len(clip)]
+		input := polygon
+		polygon = nil
+		for j := range input {
+			cur, prev := input[j], input[(j+len(input)-1)%len(input)]
+			curIn, prevIn := cross(a, b, cur) >= 0, cross(a, b, prev) >= 0
+			if curIn != prevIn {
+				polygon = append(polygon, lineIntersection(a, b, prev, cur))
+			}
+			if curIn {
+				polygon = append(polygon, cur)
+			}
+		}
+		if len(polygon) == 0 {
+			return 0
+		}
+	}
+	return polygonArea(polygon) / refArea * 100
+}
+
+// lineIntersection returns the intersection of line a-b and segment p-q.
+func lineIntersection(a, b, p, q Chromaticity) Chromaticity {
+	cp, cq := cross(a, b, p), cross(a, b, q)
+	t := cp / (cp - cq)
+	return Chromaticity{p.X + t*(q.X-p.X), p.Y + t*(q.Y-p.Y)}
+}
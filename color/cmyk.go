@@ -0,0 +1,121 @@
+package color
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/num"
+)
+
+// CMYK is a device dependent CMYK color. Each component is in range [0, 1].
+//
+// Without an ICC profile there is no exact mapping between sRGB and CMYK, so
+// the conversions are done through an InkProfile. The default profile is a
+// naive separation which is good enough to approximate print values.
+type CMYK struct {
+	C, M, Y, K float64
+}
+
+var _ digitalColor = (*CMYK)(nil)
+
+// InkProfile describes how colors are separated into inks and composed back.
+// Both functions work with normalized (0-1) gamma encoded sRGB channels.
+type InkProfile struct {
+	// Separate converts sRGB channels to CMYK.
+	Separate func(r, g, b float64) CMYK
+	// Compose converts CMYK to sRGB channels.
+	Compose func(c CMYK) (float64, float64, float64)
+}
+
+// NaiveInkProfile is the textbook separation with full black generation. It
+// ignores ink gain, paper white and ink limits.
+var NaiveInkProfile = InkProfile{
+	Separate: naiveSeparate,
+	Compose:  naiveCompose,
+}
+
+// DefaultInkProfile is the profile used by CMYKFromARGB and CMYK.ToARGB.
+var DefaultInkProfile = &NaiveInkProfile
+
+func naiveSeparate(r, g, b float64) CMYK {
+	k := 1 - max(r, g, b)
+	if k >= 1 {
+		return CMYK{0, 0, 0, 1}
+	}
+	c := (1 - r - k) / (1 - k)
+	m := (1 - g - k) / (1 - k)
+	y := (1 - b - k) / (1 - k)
+	return CMYK{c, m, y, k}
+}
+
+func naiveCompose(c CMYK) (float64, float64, float64) {
+	r := (1 - c.C) * (1 - c.K)
+	g := (1 - c.M) * (1 - c.K)
+	b := (1 - c.Y) * (1 - c.K)
+	return r, g, b
+}
+
+// NewCMYK creates a CMYK color. Components are clamped to [0, 1].
+func NewCMYK(c, m, y, k float64) CMYK {
+	return CMYK{
+		num.Clamp(0, 1, c),
+		num.Clamp(0, 1, m),
+		num.Clamp(0, 1, y),
+		num.Clamp(0, 1, k),
+	}
+}
+
+// CMYKFromARGB separates c into CMYK using DefaultInkProfile.
+func CMYKFromARGB(c ARGB) CMYK {
+	return CMYKFromARGBInProfile(c, DefaultInkProfile)
+}
+
+// CMYKFromARGBInProfile separates c into CMYK using the given profile.
+func CMYKFromARGBInProfile(c ARGB, profile *InkProfile) CMYK {
+	r := float64(c.Red()) / 0xFF
+	g := float64(c.Green()) / 0xFF
+	b := float64(c.Blue()) / 0xFF
+	cmyk := profile.Separate(r, g, b)
+	return NewCMYK(cmyk.Values())
+}
+
+// Values returns c, m, y, k values of CMYK color
+func (c CMYK) Values() (float64, float64, float64, float64) {
+	return c.C, c.M, c.Y, c.K
+}
+
+// ToARGB composes c into ARGB using DefaultInkProfile.
+func (c CMYK) ToARGB() ARGB {
+	return c.ToARGBInProfile(DefaultInkProfile)
+}
+
+// ToARGBInProfile composes c into ARGB using the given profile.
+func (c CMYK) ToARGBInProfile(profile *InkProfile) ARGB {
+	r, g, b := profile.Compose(c)
+	return ARGBFromRGB(
+		uint8(math.Round(num.Clamp(0, 1, r)*0xFF)),
+		uint8(math.Round(num.Clamp(0, 1, g)*0xFF)),
+		uint8(math.Round(num.Clamp(0, 1, b)*0xFF)),
+	)
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c CMYK) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c CMYK) ToXYZ() XYZ {
+	return c.ToARGB().ToXYZ()
+}
+
+func (c CMYK) ToLab() Lab {
+	return c.ToARGB().ToLab()
+}
+
+func (c CMYK) ToHct() Hct {
+	return c.ToARGB().ToHct()
+}
+
+func (c CMYK) ToCam() *Cam16 {
+	return c.ToARGB().ToCam()
+}
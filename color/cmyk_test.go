@@ -0,0 +1,42 @@
+package color
+
+import "testing"
+
+func sameCMYK(a, b CMYK) bool {
+	return almostEqual(a.C, b.C) && almostEqual(a.M, b.M) &&
+		almostEqual(a.Y, b.Y) && almostEqual(a.K, b.K)
+}
+
+func TestCMYK_FromARGB(t *testing.T) {
+	testFromARGB(t, CMYKFromARGB, sameCMYK, []fromARGBCase[CMYK]{
+		{"White", 0xFFFFFFFF, CMYK{0, 0, 0, 0}},
+		{"Black", 0xFF000000, CMYK{0, 0, 0, 1}},
+		{"Red", 0xFFFF0000, CMYK{0, 1, 1, 0}},
+		{"Cyan", 0xFF00FFFF, CMYK{1, 0, 0, 0}},
+		{"Gray 128", 0xFF808080, CMYK{0, 0, 0, 0.498}},
+	})
+}
+
+func TestCMYK_RoundTrip(t *testing.T) {
+	testRoundTrip(t, CMYKFromARGB)
+}
+
+func TestCMYK_CustomProfile(t *testing.T) {
+	// A profile that never uses black ink
+	profile := &InkProfile{
+		Separate: func(r, g, b float64) CMYK {
+			return CMYK{1 - r, 1 - g, 1 - b, 0}
+		},
+		Compose: func(c CMYK) (float64, float64, float64) {
+			return 1 - c.C, 1 - c.M, 1 - c.Y
+		},
+	}
+
+	cmyk := CMYKFromARGBInProfile(0xFF000000, profile)
+	if cmyk != (CMYK{1, 1, 1, 0}) {
+		t.Errorf("CMYKFromARGBInProfile(black) = %v, want %v", cmyk, CMYK{1, 1, 1, 0})
+	}
+	if got := cmyk.ToARGBInProfile(profile); got != 0xFF000000 {
+		t.Errorf("ToARGBInProfile() = %s, want #000000", got.HexRGB())
+	}
+}
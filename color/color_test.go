@@ -1,6 +1,9 @@
 package color
 
-import "math"
+import (
+	"math"
+	"testing"
+)
 
 func almostEqual[T float64 | float32](a, b T) bool {
 	return math.Abs(float64(a-b)) <= 0.01
@@ -14,6 +17,51 @@ func sameLab(a, b Lab) bool {
 	return almostEqual(a.L, b.L) && almostEqual(a.A, b.A) && almostEqual(a.B, b.B)
 }
 
+// valuer is a color with three components.
+type valuer interface {
+	Values() (float64, float64, float64)
+}
+
+func sameValues[T valuer](a, b T) bool {
+	a1, a2, a3 := a.Values()
+	b1, b2, b3 := b.Values()
+	return almostEqual(a1, b1) && almostEqual(a2, b2) && almostEqual(a3, b3)
+}
+
+// fromARGBCase is the reference value of an ARGB color in another color space.
+type fromARGBCase[T any] struct {
+	name string
+	argb ARGB
+	want T
+}
+
+// testFromARGB checks the colors converted with from against the reference
+// values of tests.
+func testFromARGB[T any](t *testing.T, from func(ARGB) T, same func(a, b T) bool, tests []fromARGBCase[T]) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := from(tt.argb); !same(got, tt.want) {
+				t.Errorf("%T from %s = %v, want %v", got, tt.argb.HexRGB(), got, tt.want)
+			}
+		})
+	}
+}
+
+// testRoundTrip checks that every color of ColorTestCases is unchanged after
+// converting it with from and back to ARGB.
+func testRoundTrip[T interface{ ToARGB() ARGB }](t *testing.T, from func(ARGB) T) {
+	t.Helper()
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			c := from(tt.ARGB)
+			if got := c.ToARGB(); got != tt.ARGB {
+				t.Errorf("%T(%s) Round Trip = %s, want %s", c, tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+		})
+	}
+}
+
 type ColorTestCase struct {
 	Name string
 	// Stored as 0xAARRGGBB
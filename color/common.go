@@ -24,8 +24,12 @@ var (
 		0.05562093689691305, -0.20395524564742123, 1.0571799111220335,
 	)
 
-	// The white most point of XYZ to sRGB color space
-	WhitePointD65 = num.NewVector3(95.047, 100.0, 108.883)
+	// WhitePointD65 is IlluminantD65 as a vector, the white point of sRGB.
+	WhitePointD65 = num.NewVector3(IlluminantD65.Values())
+
+	// WhitePointD50 is IlluminantD50 as a vector, used by print and ICC
+	// workflows.
+	WhitePointD50 = num.NewVector3(IlluminantD50.Values())
 
 	CriticalPlanes = []float64{
 		0.015176349177441876, 0.045529047532325624, 0.07588174588720938,
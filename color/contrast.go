@@ -0,0 +1,31 @@
+package color
+
+import "math"
+
+// CompositeOver returns c drawn over bg with source over alpha compositing in
+// gamma encoded sRGB, like browsers and Android do. The alpha of bg is ignored
+// and the result is opaque.
+func (c ARGB) CompositeOver(bg ARGB) ARGB {
+	alpha := float64(c.Alpha()) / 0xFF
+	mix := func(fg, bg uint8) uint8 {
+		return uint8(math.Round(float64(fg)*alpha + float64(bg)*(1-alpha)))
+	}
+	return NewARGB(0xFF, mix(c.Red(), bg.Red()), mix(c.Green(), bg.Green()), mix(c.Blue(), bg.Blue()))
+}
+
+// RelativeLuminance returns the relative luminance of c as defined by WCAG in
+// range [0, 1]. Alpha is ignored.
+func (c ARGB) RelativeLuminance() float64 {
+	return c.ToXYZ().Y / 100
+}
+
+// ContrastRatio returns the WCAG 2 contrast ratio between c and other in range
+// [1, 21]. c is the foreground: if it is translucent, it is composited over
+// other before measuring. The alpha of other is ignored.
+func (c ARGB) ContrastRatio(other ARGB) float64 {
+	if c.Alpha() != 0xFF {
+		c = c.CompositeOver(other)
+	}
+	l1, l2 := c.RelativeLuminance(), other.RelativeLuminance()
+	return (max(l1, l2) + 0.05) / (min(l1, l2) + 0.05)
+}
@@ -0,0 +1,37 @@
+package color
+
+import "testing"
+
+func TestARGB_ContrastRatio(t *testing.T) {
+	tests := []struct {
+		name   string
+		fg, bg ARGB
+		want   float64
+	}{
+		{"black on white", 0xFF000000, 0xFFFFFFFF, 21},
+		{"white on black", 0xFFFFFFFF, 0xFF000000, 21},
+		{"same color", 0xFF6750A4, 0xFF6750A4, 1},
+		{"gray on white", 0xFF767676, 0xFFFFFFFF, 4.5422},
+		{"primary on white", 0xFF6750A4, 0xFFFFFFFF, 6.4412},
+		{"transparent on white", 0x00000000, 0xFFFFFFFF, 1},
+		{"half black on white", 0x80000000, 0xFFFFFFFF, 4.0041},
+		{"background alpha is ignored", 0xFF000000, 0x00FFFFFF, 21},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fg.ContrastRatio(tt.bg); !almostEqual(got, tt.want) {
+				t.Errorf("ContrastRatio() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestARGB_CompositeOver(t *testing.T) {
+	if got := ARGB(0x80000000).CompositeOver(0xFFFFFFFF); got != 0xFF7F7F7F {
+		t.Errorf("CompositeOver() = %s, want #7F7F7F", got.HexARGB())
+	}
+	if got := ARGB(0xFF6750A4).CompositeOver(0x00FFFFFF); got != 0xFF6750A4 {
+		t.Errorf("CompositeOver() = %s, want #6750A4", got.HexARGB())
+	}
+}
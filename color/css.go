@@ -0,0 +1,413 @@
+package color
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/Nadim147c/material/num"
+)
+
+// cssFunction is a parsed CSS functional notation like `rgb(255 0 0 / 50%)`.
+type cssFunction struct {
+	// name is the lower case function name
+	name string
+	// args are the color components without alpha
+	args []string
+	// alpha is the alpha component or empty string if missing
+	alpha string
+	// legacy is true when components are separated with commas
+	legacy bool
+	// clamp allows out of range components
+	clamp bool
+}
+
+// parseCSSFunction splits s into function name and its arguments.
+func parseCSSFunction(s string) (cssFunction, error) {
+	var fn cssFunction
+
+	s = strings.TrimSpace(s)
+	open := strings.IndexByte(s, '(')
+	if open <= 0 || !strings.HasSuffix(s, ")") {
+		return fn, fmt.Errorf("invalid css function: %q", s)
+	}
+	fn.name = strings.ToLower(strings.TrimSpace(s[:open]))
+	body := strings.TrimSpace(s[open+1 : len(s)-1])
+
+	if strings.Contains(body, ",") {
+		fn.legacy = true
+		for arg := range strings.SplitSeq(body, ",") {
+			arg = strings.TrimSpace(arg)
+			if arg == "" {
+				return fn, fmt.Errorf("empty argument in %q", s)
+			}
+			fn.args = append(fn.args, arg)
+		}
+		if len(fn.args) == 4 {
+			fn.alpha = fn.args[3]
+			fn.args = fn.args[:3]
+		}
+		return fn, nil
+	}
+
+	fields := strings.Fields(strings.ReplaceAll(body, "/", " / "))
+	for i, field := range fields {
+		if field != "/" {
+			continue
+		}
+		if i != len(fields)-2 {
+			return fn, fmt.Errorf("invalid alpha in %q", s)
+		}
+		fn.alpha = fields[i+1]
+		fields = fields[:i]
+		break
+	}
+	fn.args = fields
+	return fn, nil
+}
+
+// cssNumber parses a CSS number or percentage. The keyword none is parsed as
+// zero.
+func cssNumber(s string) (value float64, percent bool, err error) {
+	s = strings.ToLower(s)
+	if s == "none" {
+		return 0, false, nil
+	}
+	if p, ok := strings.CutSuffix(s, "%"); ok {
+		s, percent = p, true
+	}
+	value, err = strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, false, fmt.Errorf("invalid number: %q", s)
+	}
+	return value, percent, nil
+}
+
+// cssAngle parses a CSS hue angle in degrees. Supported units are deg, grad,
+// rad and turn. A unitless number is in degrees.
+func cssAngle(s string) (float64, error) {
+	s = strings.ToLower(s)
+	units := []struct {
+		suffix string
+		scale  float64
+	}{
+		{"deg", 1},
+		{"grad", 0.9},
+		{"rad", 180 / math.Pi},
+		{"turn", 360},
+	}
+	scale := 1.0
+	for _, unit := range units {
+		if v, ok := strings.CutSuffix(s, unit.suffix); ok {
+			s, scale = v, unit.scale
+			break
+		}
+	}
+	value, percent, err := cssNumber(s)
+	if err != nil || percent {
+		return 0, fmt.Errorf("invalid angle: %q", s)
+	}
+	return num.NormalizeDegree(value * scale), nil
+}
+
+// cssAlpha parses a CSS alpha value as number in [0, 1] or percentage. A
+// missing alpha is fully opaque. Out of range values are clamped if clamp is
+// true, otherwise an error is returned.
+func cssAlpha(s string, clamp bool) (float64, error) {
+	if s == "" {
+		return 1, nil
+	}
+	value, percent, err := cssNumber(s)
+	if err != nil {
+		return 0, err
+	}
+	if percent {
+		value /= 100
+	}
+	if !clamp && (value < 0 || value > 1) {
+		return 0, fmt.Errorf("alpha out of range: %q", s)
+	}
+	return math.Max(0, math.Min(1, value)), nil
+}
+
+// to8Bit converts a value in [0, 1] to a 8-bit component, clamping values
+// outside of the range.
+func to8Bit(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(1, v)) * 255))
+}
+
+// ARGBFromCSSMust is like ARGBFromCSS but panics on error.
+func ARGBFromCSSMust(s string) ARGB {
+	color, err := ARGBFromCSS(s)
+	if err != nil {
+		panic(err)
+	}
+	return color
+}
+
+// ARGBFromCSS parses a CSS color string and returns a ARGB. It supports hex
+// notation and rgb(), rgba(), hsl(), hsla(), hwb(), lab(), lch(), oklab() and
+// oklch() functions and color() function with predefined color spaces.
+// rgb() and hsl() accept both modern and legacy comma
+// syntax, e.g. `rgb(255 0 0 / 50%)` or `hsla(120deg, 100%, 50%, 0.5)`. Colors
+// outside of sRGB gamut are gamut mapped with GamutMap.
+func ARGBFromCSS(s string) (ARGB, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "(") {
+		return ARGBFromHex(s)
+	}
+	return argbFromCSS(s, LenientParse)
+}
+
+// argbFromCSS parses CSS functional notation with given options.
+func argbFromCSS(s string, opts ParseOptions) (ARGB, error) {
+	fn, err := parseCSSFunction(s)
+	if err != nil {
+		return 0, err
+	}
+	fn.clamp = opts.Clamp
+
+	switch fn.name {
+	case "rgb", "rgba":
+		return argbFromCSSRGB(fn)
+	case "hsl", "hsla":
+		return argbFromCSSHSL(fn)
+	case "hwb":
+		return argbFromCSSHWB(fn)
+	case "lab", "lch", "oklab", "oklch":
+		return argbFromCSSLab(fn)
+	case "color":
+		return argbFromCSSColor(fn)
+	default:
+		return 0, fmt.Errorf("unsupported css function: %q", fn.name)
+	}
+}
+
+// argbFromCSSRGB converts rgb() and rgba() functions to ARGB.
+func argbFromCSSRGB(fn cssFunction) (ARGB, error) {
+	if len(fn.args) != 3 {
+		return 0, fmt.Errorf("%s() requires 3 components, got %d", fn.name, len(fn.args))
+	}
+
+	var rgb [3]float64
+	percents := 0
+	for i, arg := range fn.args {
+		if fn.legacy && strings.EqualFold(arg, "none") {
+			return 0, errors.New("none is not allowed in legacy rgb() syntax")
+		}
+		value, percent, err := cssNumber(arg)
+		if err != nil {
+			return 0, err
+		}
+		if percent {
+			percents++
+			rgb[i] = value / 100
+		} else {
+			rgb[i] = value / 255
+		}
+		if !fn.clamp && (rgb[i] < 0 || rgb[i] > 1) {
+			return 0, fmt.Errorf("rgb() component out of range: %q", arg)
+		}
+	}
+	if fn.legacy && percents != 0 && percents != 3 {
+		return 0, errors.New("legacy rgb() can not mix numbers and percentages")
+	}
+
+	alpha, err := cssAlpha(fn.alpha, fn.clamp)
+	if err != nil {
+		return 0, err
+	}
+
+	return NewARGB(to8Bit(alpha), to8Bit(rgb[0]), to8Bit(rgb[1]), to8Bit(rgb[2])), nil
+}
+
+// cssHueAndPercents parses a hue followed by two percentages as used by hsl()
+// and hwb(). Numbers without percent sign are only allowed in modern syntax.
+func cssHueAndPercents(fn cssFunction) (h, p1, p2, alpha float64, err error) {
+	if len(fn.args) != 3 {
+		err = fmt.Errorf("%s() requires 3 components, got %d", fn.name, len(fn.args))
+		return
+	}
+	if h, err = cssAngle(fn.args[0]); err != nil {
+		return
+	}
+
+	var values [2]float64
+	for i, arg := range fn.args[1:] {
+		if fn.legacy && strings.EqualFold(arg, "none") {
+			err = fmt.Errorf("none is not allowed in legacy %s() syntax", fn.name)
+			return
+		}
+		value, percent, perr := cssNumber(arg)
+		if perr != nil {
+			err = perr
+			return
+		}
+		if fn.legacy && !percent {
+			err = fmt.Errorf("legacy %s() requires percentages: %q", fn.name, arg)
+			return
+		}
+		values[i] = value
+	}
+
+	alpha, err = cssAlpha(fn.alpha, fn.clamp)
+	return h, values[0], values[1], alpha, err
+}
+
+// argbFromCSSHSL converts hsl() and hsla() functions to ARGB.
+func argbFromCSSHSL(fn cssFunction) (ARGB, error) {
+	h, s, l, alpha, err := cssHueAndPercents(fn)
+	if err != nil {
+		return 0, err
+	}
+	r, g, b := NewHSL(h, s, l).rgb()
+	return NewARGB(to8Bit(alpha), to8Bit(r), to8Bit(g), to8Bit(b)), nil
+}
+
+// argbFromCSSHWB converts hwb() function to ARGB.
+func argbFromCSSHWB(fn cssFunction) (ARGB, error) {
+	if fn.legacy {
+		return 0, errors.New("hwb() does not support comma syntax")
+	}
+	h, w, bl, alpha, err := cssHueAndPercents(fn)
+	if err != nil {
+		return 0, err
+	}
+	r, g, b := NewHWB(h, w, bl).rgb()
+	return NewARGB(to8Bit(alpha), to8Bit(r), to8Bit(g), to8Bit(b)), nil
+}
+
+// cssComponent parses a number or percentage where 100% equals full.
+func cssComponent(s string, full float64) (float64, error) {
+	value, percent, err := cssNumber(s)
+	if err != nil {
+		return 0, err
+	}
+	if percent {
+		value = value / 100 * full
+	}
+	return value, nil
+}
+
+// labD50ToXYZ converts CIE Lab with D50 white point, as used by CSS, to D65
+// referenced XYZ.
+func labD50ToXYZ(l, a, b float64) XYZ {
+	return Lab{l, a, b}.ToXYZIn(IlluminantD50).Adapt(IlluminantD50, IlluminantD65, Bradford)
+}
+
+// argbFromCSSLab converts lab(), lch(), oklab() and oklch() functions to ARGB.
+// lab() and lch() use D50 white point as defined by CSS Color 4.
+func argbFromCSSLab(fn cssFunction) (ARGB, error) {
+	if fn.legacy {
+		return 0, fmt.Errorf("%s() does not support comma syntax", fn.name)
+	}
+	if len(fn.args) != 3 {
+		return 0, fmt.Errorf("%s() requires 3 components, got %d", fn.name, len(fn.args))
+	}
+
+	// Value of 100% for lightness and chroma or a and b
+	fullL, fullC := 100.0, 125.0
+	polar := strings.HasSuffix(fn.name, "lch")
+	switch fn.name {
+	case "lch":
+		fullC = 150
+	case "oklab", "oklch":
+		fullL, fullC = 1, 0.4
+	}
+
+	l, err := cssComponent(fn.args[0], fullL)
+	if err != nil {
+		return 0, err
+	}
+	l = num.Clamp(0, fullL, l)
+
+	x, err := cssComponent(fn.args[1], fullC)
+	if err != nil {
+		return 0, err
+	}
+
+	var y float64
+	if polar {
+		y, err = cssAngle(fn.args[2])
+	} else {
+		y, err = cssComponent(fn.args[2], fullC)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	alpha, err := cssAlpha(fn.alpha, fn.clamp)
+	if err != nil {
+		return 0, err
+	}
+
+	var argb ARGB
+	switch fn.name {
+	case "lab":
+		argb, _ = GamutMapXYZ(labD50ToXYZ(l, x, y))
+	case "lch":
+		hRad := num.Radian(y)
+		c := max(0, x)
+		argb, _ = GamutMapXYZ(labD50ToXYZ(l, c*math.Cos(hRad), c*math.Sin(hRad)))
+	case "oklab":
+		argb, _ = GamutMap(NewOkLab(l, x, y).ToOkLch())
+	case "oklch":
+		argb, _ = GamutMap(NewOkLch(l, max(0, x), y))
+	}
+	return NewARGB(to8Bit(alpha), argb.Red(), argb.Green(), argb.Blue()), nil
+}
+
+// cssColorSpaces are the predefined RGB color spaces of CSS color() function.
+var cssColorSpaces = map[string]*RGBColorSpace{
+	SRGBSpace.Name:       SRGBSpace,
+	LinearSRGBSpace.Name: LinearSRGBSpace,
+	DisplayP3Space.Name:  DisplayP3Space,
+	Rec2020Space.Name:    Rec2020Space,
+	AdobeRGBSpace.Name:   AdobeRGBSpace,
+	ProPhotoSpace.Name:   ProPhotoSpace,
+}
+
+// argbFromCSSColor converts color() function to ARGB. Supported spaces are
+// srgb, srgb-linear, display-p3, rec2020, a98-rgb, prophoto-rgb, xyz, xyz-d65
+// and xyz-d50.
+func argbFromCSSColor(fn cssFunction) (ARGB, error) {
+	if fn.legacy {
+		return 0, errors.New("color() does not support comma syntax")
+	}
+	if len(fn.args) != 4 {
+		return 0, fmt.Errorf("color() requires a color space and 3 components, got %d", len(fn.args))
+	}
+
+	var c [3]float64
+	for i, arg := range fn.args[1:] {
+		value, err := cssComponent(arg, 1)
+		if err != nil {
+			return 0, err
+		}
+		c[i] = value
+	}
+
+	alpha, err := cssAlpha(fn.alpha, fn.clamp)
+	if err != nil {
+		return 0, err
+	}
+
+	var xyz XYZ
+	switch name := strings.ToLower(fn.args[0]); name {
+	case "xyz", "xyz-d65":
+		xyz = XYZ{c[0] * 100, c[1] * 100, c[2] * 100}
+	case "xyz-d50":
+		xyz = XYZ{c[0] * 100, c[1] * 100, c[2] * 100}.Adapt(IlluminantD50, IlluminantD65, Bradford)
+	default:
+		space, ok := cssColorSpaces[name]
+		if !ok {
+			return 0, fmt.Errorf("unsupported color space: %q", name)
+		}
+		xyz = space.ToXYZ(c[0], c[1], c[2])
+	}
+
+	argb, _ := GamutMapXYZ(xyz)
+	return NewARGB(to8Bit(alpha), argb.Red(), argb.Green(), argb.Blue()), nil
+}
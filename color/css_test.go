@@ -0,0 +1,76 @@
+package color
+
+import "testing"
+
+func TestARGBFromCSS(t *testing.T) {
+	tests := []struct {
+		name    string
+		css     string
+		want    ARGB
+		wantErr bool
+	}{
+		{"Hex", "#FF0000", 0xFFFF0000, false},
+		{"Modern", "rgb(255 0 0)", 0xFFFF0000, false},
+		{"Modern Alpha Percent", "rgb(255 0 0 / 50%)", 0x80FF0000, false},
+		{"Modern Alpha Number", "rgb(0 255 0/0.25)", 0x4000FF00, false},
+		{"Modern Percent", "rgb(100% 50% 0%)", 0xFFFF8000, false},
+		{"Modern Mixed", "rgb(255 50% none)", 0xFFFF8000, false},
+		{"Legacy", "rgb(0, 0, 255)", 0xFF0000FF, false},
+		{"Legacy Rgba", "rgba(0, 0, 255, 0.5)", 0x800000FF, false},
+		{"Legacy Percent", "rgba(100%, 0%, 0%, 100%)", 0xFFFF0000, false},
+		{"Upper Case", "RGB(255 255 255)", 0xFFFFFFFF, false},
+		{"Clamped", "rgb(300 -20 128)", 0xFFFF0080, false},
+		{"Whitespace", "  rgb(  10   20  30  )  ", 0xFF0A141E, false},
+		{"HSL", "hsl(120 100% 50%)", 0xFF00FF00, false},
+		{"HSL Numbers", "hsl(240 100 50 / 0.5)", 0x800000FF, false},
+		{"HSL Turn", "hsl(0.5turn 100% 25%)", 0xFF008080, false},
+		{"HSL Rad", "hsl(3.14159265rad 100% 50%)", 0xFF00FFFF, false},
+		{"HSL Grad", "hsl(200grad 100% 50%)", 0xFF00FFFF, false},
+		{"HSL Legacy", "hsla(0deg, 0%, 100%, 50%)", 0x80FFFFFF, false},
+		{"HSL Legacy Number", "hsl(0, 100, 50)", 0, true},
+		{"HWB", "hwb(0 0% 0%)", 0xFFFF0000, false},
+		{"HWB Gray", "hwb(90 60% 60%)", 0xFF808080, false},
+		{"HWB Tint", "hwb(120deg 20% 20% / 25%)", 0x4033CC33, false},
+		{"HWB Legacy", "hwb(0, 0%, 0%)", 0, true},
+		{"Invalid Angle", "hsl(10% 100% 50%)", 0, true},
+		{"Lab White", "lab(100 0 0)", 0xFFFFFFFF, false},
+		{"Lab Red", "lab(54.29 80.8 69.89)", 0xFFFF0000, false},
+		{"Lab Percent", "lab(0% 0% 0% / 50%)", 0x80000000, false},
+		{"Lch Red", "lch(54.29 106.84 40.85deg)", 0xFFFF0000, false},
+		{"Lch Gamut Mapped", "lch(50% 200 0)", 0xFFFF0089, false},
+		{"Oklab Red", "oklab(62.8% 0.2249 0.1258)", 0xFFFF0000, false},
+		{"Oklch Green", "oklch(0.866454 0.294804 142.507)", 0xFF00FF00, false},
+		{"Oklch Percent", "oklch(100% 0% 0)", 0xFFFFFFFF, false},
+		{"Oklch None", "oklch(0.5 none none)", 0xFF636363, false},
+		{"Lab Legacy", "lab(50, 0, 0)", 0, true},
+		{"Color sRGB", "color(srgb 1 0 0)", 0xFFFF0000, false},
+		{"Color sRGB Percent", "color(srgb 100% 100% 100% / 0.5)", 0x80FFFFFF, false},
+		{"Color Linear", "color(srgb-linear 0.2158605 0.2158605 0.2158605)", 0xFF808080, false},
+		{"Color P3 White", "color(display-p3 1 1 1)", 0xFFFFFFFF, false},
+		{"Color P3 Red", "color(display-p3 1 0 0)", 0xFFFF0B0C, false},
+		{"Color Rec2020 Black", "color(rec2020 0 0 0)", 0xFF000000, false},
+		{"Color XYZ", "color(xyz-d65 0.95047 1 1.08883)", 0xFFFFFFFF, false},
+		{"Color XYZ D50", "color(xyz-d50 0.96422 1 0.82521)", 0xFFFFFFFF, false},
+		{"Color Unknown Space", "color(foo 1 0 0)", 0, true},
+		{"Color Missing Space", "color(1 0 0)", 0, true},
+		{"Legacy Mixed", "rgb(255, 50%, 0)", 0, true},
+		{"Legacy None", "rgb(255, none, 0)", 0, true},
+		{"Missing Component", "rgb(255 0)", 0, true},
+		{"Invalid Number", "rgb(255 0 abc)", 0, true},
+		{"Unclosed", "rgb(255 0 0", 0, true},
+		{"Unknown Function", "foo(1 2 3)", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ARGBFromCSS(tt.css)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ARGBFromCSS(%q) error = %v, wantErr %v", tt.css, err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ARGBFromCSS(%q) = %s, want %s", tt.css, got.HexARGB(), tt.want.HexARGB())
+			}
+		})
+	}
+}
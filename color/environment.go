@@ -6,10 +6,12 @@ import (
 	"github.com/Nadim147c/material/num"
 )
 
-// Environmnet encapsulates all constants needed for CAM16 color conversions.
+// Environment encapsulates all constants needed for CAM16 color conversions.
 // These are intermediate values derived from the viewing environment and are used
 // throughout the CAM16 model to compute perceptual color attributes.
-type Environmnet struct {
+type Environment struct {
+	// WhitePoint is the XYZ of the reference white with Y = 100.
+	WhitePoint XYZ
 	// N is the relative luminance of the background relative to the reference white.
 	N float64
 	// Aw is the achromatic response to the white point.
@@ -30,23 +32,104 @@ type Environmnet struct {
 	FlRoot float64
 	// Z is a base exponential factor used in the CAM16 J calculation.
 	Z float64
+
+	// adapt is true when WhitePoint is not D65. fromD65 and toD65 adapt D65
+	// referred XYZ to WhitePoint and back.
+	adapt   bool
+	fromD65 num.Matrix3
+	toD65   num.Matrix3
 }
 
-// DefaultEnviroment returns the default sRGB-like viewing conditions.
-var DefaultEnviroment = NewEnvironment((200/math.Pi)*YFromLstar(50)/100, 50, 2, false)
+// Environmnet is the former misspelled name of Environment.
+//
+// Deprecated: Use Environment.
+type Environmnet = Environment
+
+// DefaultEnvironment is the default sRGB-like viewing conditions: D65 white,
+// adapting luminance of 11.72 cd/m², mid gray background and average
+// surround.
+var DefaultEnvironment = NewEnvironment(
+	IlluminantD65,
+	(200/math.Pi)*YFromLstar(50)/100, 50, 2, false,
+)
+
+// Preset environments. They use D65 white and do not discount the illuminant
+// unless noted.
+var (
+	// AverageEnvironment is an office or living room lit around 200 lux with a
+	// mid gray background. It is the same as DefaultEnvironment.
+	AverageEnvironment = DefaultEnvironment
+	// DimEnvironment is a dimly lit room, e.g. watching television in the
+	// evening.
+	DimEnvironment = NewEnvironment(
+		IlluminantD65,
+		(40/math.Pi)*YFromLstar(50)/100, 30, 1, false,
+	)
+	// DarkEnvironment is a dark room where the display is the only light, e.g.
+	// a phone in bed or a cinema.
+	DarkEnvironment = NewEnvironment(
+		IlluminantD65,
+		(5/math.Pi)*YFromLstar(50)/100, 30, 0, false,
+	)
+	// PrintEnvironment is a print viewing booth of ISO 3664 with D50 light of
+	// 2000 lux and a mid gray background. The eye fully adapts to the light.
+	PrintEnvironment = NewEnvironment(
+		IlluminantD50,
+		(2000/math.Pi)*YFromLstar(50)/100, 50, 2, true,
+	)
+)
+
+// EnvironmentWithBackground returns DefaultEnvironment with a background of
+// the given L*, like dynamic colors drawn on a surface of that tone.
+func EnvironmentWithBackground(lstar float64) Environment {
+	return NewEnvironment(
+		IlluminantD65,
+		(200/math.Pi)*YFromLstar(50)/100, lstar, 2, false,
+	)
+}
 
-// NewEnvironment creates a ViewingConditions instance with the specified parameters.
+// DefaultEnviroment is the former misspelled name of DefaultEnvironment.
+//
+// Deprecated: Use DefaultEnvironment.
+var DefaultEnviroment = DefaultEnvironment
+
+// NewEnvironment creates viewing conditions for CAM16.
+//
+//   - whitePoint is the XYZ of the reference white with Y = 100
+//   - adaptingLuminance is the luminance of the adapting field in cd/m²,
+//     usually 20% of the luminance of a white object in the scene
+//   - backgroundLstar is the L* of the background, values below 30 are
+//     raised to 30
+//   - surround is 0 for dark, 1 for dim and 2 for average surround, values in
+//     between are interpolated
+//   - discounting is true when the eye fully adapts to the illuminant, e.g.
+//     for reflective colors under a single light source
+//
+// Like the rest of this package, Cam16FromXyzInEnv and Cam16.Viewed work with
+// D65 referred XYZ. When whitePoint is not D65, colors are adapted to
+// whitePoint with the Bradford transform first, so they are modeled as lit by
+// that light, e.g. ChromaticityA.ToXYZ(100) for warm incandescent light.
+// Without discounting, the eye adapts only partially and colors keep a tint
+// of the light.
 func NewEnvironment(
+	whitePoint XYZ,
 	adaptingLuminance float64,
 	backgroundLstar float64,
 	surround float64,
-	discountingIlluminant bool,
-) Environmnet {
+	discounting bool,
+) Environment {
 	if backgroundLstar < 30.0 {
 		backgroundLstar = 30.0
 	}
 
-	rW, gW, bW := Cat16Matrix.Multiply(WhitePointD65).Values()
+	adapt := whitePoint != IlluminantD65
+	var fromD65, toD65 num.Matrix3
+	if adapt {
+		fromD65 = Bradford.AdaptationMatrix(IlluminantD65, whitePoint)
+		toD65 = Bradford.AdaptationMatrix(whitePoint, IlluminantD65)
+	}
+
+	rW, gW, bW := Cat16Matrix.MultiplyXYZ(whitePoint.Values()).Values()
 
 	f := 0.8 + surround/10
 	var c float64
@@ -57,7 +140,7 @@ func NewEnvironment(
 	}
 
 	var d float64
-	if discountingIlluminant {
+	if discounting {
 		d = 1
 	} else {
 		d = f * (1 - (1/3.6)*math.Exp((-adaptingLuminance-42)/92))
@@ -81,7 +164,7 @@ func NewEnvironment(
 	k4F := 1 - k4
 	fl := k4*adaptingLuminance + 0.1*k4F*k4F*math.Cbrt(5*adaptingLuminance)
 
-	n := YFromLstar(backgroundLstar) / WhitePointD65[1]
+	n := YFromLstar(backgroundLstar) / whitePoint.Y
 	z := 1.48 + math.Sqrt(n)
 	nbb := 0.725 / math.Pow(n, 0.2)
 	ncb := nbb
@@ -100,10 +183,14 @@ func NewEnvironment(
 
 	aw := (2.0*rgbA[0] + rgbA[1] + 0.05*rgbA[2]) * nbb
 
-	return Environmnet{
+	return Environment{
 		N: n, Aw: aw, Nbb: nbb,
 		Ncb: ncb, C: c, Nc: nc,
 		RgbD: rgbD, Fl: fl, Z: z,
-		FlRoot: math.Pow(fl, 0.25),
+		FlRoot:     math.Pow(fl, 0.25),
+		WhitePoint: whitePoint,
+		adapt:      adapt,
+		fromD65:    fromD65,
+		toD65:      toD65,
 	}
 }
@@ -5,23 +5,78 @@ import (
 	"testing"
 )
 
-func TestMakeViewingConditions_Default(t *testing.T) {
+func TestNewEnvironment_Default(t *testing.T) {
 	adaptingLuminance := (200.0 / math.Pi) * YFromLstar(50.0) / 100.0
-	backgroundLstar := 50.0
-	surround := 2.0
-	discountingIlluminant := false
-
-	v := NewEnvironment(adaptingLuminance, backgroundLstar, surround, discountingIlluminant)
-
-	t.Logf("ViewingConditions:")
-	t.Logf("  N:      %.6f", v.N)
-	t.Logf("  Aw:     %.6f", v.Aw)
-	t.Logf("  Nbb:    %.6f", v.Nbb)
-	t.Logf("  Ncb:    %.6f", v.Ncb)
-	t.Logf("  C:      %.6f", v.C)
-	t.Logf("  Nc:     %.6f", v.Nc)
-	t.Logf("  RgbD:   [%.6f, %.6f, %.6f]", v.RgbD[0], v.RgbD[1], v.RgbD[2])
-	t.Logf("  Fl:     %.6f", v.Fl)
-	t.Logf("  FlRoot: %.6f", v.FlRoot)
-	t.Logf("  Z:      %.6f", v.Z)
+	v := NewEnvironment(IlluminantD65, adaptingLuminance, 50, 2, false)
+
+	if v != DefaultEnvironment {
+		t.Errorf("NewEnvironment() = %+v, want DefaultEnvironment %+v", v, DefaultEnvironment)
+	}
+	if !almostEqual(v.N, 0.1842) || !almostEqual(v.Z, 1.9092) || !almostEqual(v.C, 0.69) ||
+		!almostEqual(v.Nc, 1) || !almostEqual(v.Fl, 0.3884) {
+		t.Errorf("NewEnvironment() = %+v", v)
+	}
+
+	// The deprecated names refer to the same environment
+	var alias Environmnet = DefaultEnviroment
+	if alias != DefaultEnvironment {
+		t.Errorf("DefaultEnviroment = %+v, want %+v", alias, DefaultEnvironment)
+	}
+}
+
+func TestNewEnvironment_WhitePoint(t *testing.T) {
+
+	// With discounting, the eye fully adapts and white stays achromatic under
+	// any light
+	for _, wp := range []Chromaticity{ChromaticityD50, ChromaticityA, {0.31, 0.35}} {
+		env := NewEnvironment(wp.ToXYZ(100), 64, 50, 2, true)
+		white := Cam16FromXyzInEnv(IlluminantD65, &env)
+		if white.Chroma > 0.5 || !almostEqual(white.J, 100) {
+			t.Errorf("white under %v = J %.2f C %.2f, want J 100 C 0", wp, white.J, white.Chroma)
+		}
+	}
+
+	// Without discounting, white keeps a warm tint of incandescent light
+	env := NewEnvironment(ChromaticityA.ToXYZ(100), 64, 50, 2, false)
+	white := Cam16FromXyzInEnv(IlluminantD65, &env)
+	if white.Chroma < 2 || white.Hue < 30 || white.Hue > 110 {
+		t.Errorf("white under illuminant A = C %.2f H %.2f, want warm tint", white.Chroma, white.Hue)
+	}
+}
+
+func TestNewEnvironment_WhitePointRoundTrip(t *testing.T) {
+	env := NewEnvironment(ChromaticityA.ToXYZ(100), 64, 50, 2, false)
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			xyz := tt.ARGB.ToXYZ()
+			got := Cam16FromXyzInEnv(xyz, &env).Viewed(&env)
+			if !almostEqual(got.X, xyz.X) || !almostEqual(got.Y, xyz.Y) || !almostEqual(got.Z, xyz.Z) {
+				t.Errorf("Viewed(Cam16FromXyzInEnv(%v)) = %v", xyz, got)
+			}
+		})
+	}
+}
+
+func TestPresetEnvironments(t *testing.T) {
+	if AverageEnvironment != DefaultEnvironment {
+		t.Errorf("AverageEnvironment != DefaultEnvironment")
+	}
+	if got := EnvironmentWithBackground(50); got != DefaultEnvironment {
+		t.Errorf("EnvironmentWithBackground(50) = %+v, want DefaultEnvironment", got)
+	}
+
+	// Mid tones look lighter as the surround gets darker
+	hct := NewHct(270, 40, 50)
+	prevJ := 0.0
+	for _, env := range []Environment{AverageEnvironment, DimEnvironment, DarkEnvironment} {
+		cam := Cam16FromXyzInEnv(hct.ToXYZ(), &env)
+		if cam.J <= prevJ {
+			t.Errorf("J = %.2f, want more than %.2f", cam.J, prevJ)
+		}
+		prevJ = cam.J
+	}
+
+	if got := Cam16FromXyzInEnv(IlluminantD65, &PrintEnvironment); got.Chroma > 0.5 {
+		t.Errorf("white in PrintEnvironment chroma = %.2f, want 0", got.Chroma)
+	}
 }
@@ -0,0 +1,52 @@
+package color
+
+import "flag"
+
+// Ensure Flag implements the flag.Value interface
+var _ flag.Value = (*Flag)(nil)
+
+// Flag is a command line flag of an ARGB variable. It implements flag.Value,
+// and with Type also pflag.Value, so colors can be used with the flag package,
+// github.com/spf13/pflag and cobra.
+//
+//	var seed color.ARGB = 0xFF6750A4
+//	flag.Var(color.NewFlag(&seed), "color", "seed color")
+type Flag struct {
+	p *ARGB
+}
+
+// NewFlag returns a Flag that stores parsed colors in p. The current value of
+// p is the default of the flag.
+func NewFlag(p *ARGB) *Flag {
+	return &Flag{p}
+}
+
+// Set implements flag.Value. It accepts every color string supported by Parse,
+// e.g. "#RRGGBB", "rgb(255 0 0)" or "tomato".
+func (f *Flag) Set(s string) error {
+	argb, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*f.p = argb
+	return nil
+}
+
+// String implements flag.Value. It returns #RRGGBB, or #RRGGBBAA for
+// translucent colors. Unlike ARGB.String, it never contains a color swatch,
+// so help text printed by flag.PrintDefaults stays plain regardless of
+// StringStyle.
+func (f *Flag) String() string {
+	if f == nil || f.p == nil {
+		return ""
+	}
+	if f.p.Alpha() == 0xFF {
+		return f.p.HexRGB()
+	}
+	return f.p.HexRGBA()
+}
+
+// Type returns the type name of the flag for pflag.Value.
+func (f *Flag) Type() string {
+	return "color"
+}
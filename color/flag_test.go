@@ -0,0 +1,60 @@
+package color
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestARGB_FlagValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    ARGB
+		wantErr bool
+	}{
+		{"Default", nil, 0xFF6750A4, false},
+		{"Hex", []string{"--color", "#FF0000"}, 0xFFFF0000, false},
+		{"Named", []string{"--color=navy"}, 0xFF000080, false},
+		{"Invalid", []string{"--color", "nope"}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+
+			c := ARGB(0xFF6750A4)
+			fs.Var(NewFlag(&c), "color", "seed color")
+			err := fs.Parse(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse(%v) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && c != tt.want {
+				t.Errorf("Parse(%v) = %s, want %s", tt.args, c.HexARGB(), tt.want.HexARGB())
+			}
+		})
+	}
+}
+
+func TestFlag_String(t *testing.T) {
+	SetStringStyle(SwatchHex)
+
+	c := ARGB(0xFF6750A4)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(NewFlag(&c), "color", "seed color")
+
+	var help strings.Builder
+	fs.SetOutput(&help)
+	fs.PrintDefaults()
+	if strings.Contains(help.String(), "\x1b") || !strings.Contains(help.String(), "#6750A4") {
+		t.Errorf("PrintDefaults() = %q, want plain #6750A4 default", help.String())
+	}
+
+	c = 0x806750A4
+	if got := NewFlag(&c).String(); got != "#6750A480" {
+		t.Errorf("String() = %q, want #6750A480", got)
+	}
+}
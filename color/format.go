@@ -0,0 +1,105 @@
+package color
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+)
+
+// Format implements fmt.Formatter.
+//
+//	%v, %s  hex with a color swatch, same as String
+//	%+v     per channel breakdown, e.g. ARGB(a=255, r=255, g=0, b=0)
+//	%x, %X  hex in lower or upper case, e.g. #ff0000. With + flag alpha is
+//	        appended, e.g. #ff0000ff
+//	%#x     packed 0xAARRGGBB integer, e.g. 0xffff0000
+//	%d      packed uint32 value
+func (c ARGB) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			a, r, g, b := c.Values()
+			fmt.Fprintf(f, "ARGB(a=%d, r=%d, g=%d, b=%d)", a, r, g, b)
+			return
+		}
+		fmt.Fprint(f, c.String())
+	case 's':
+		fmt.Fprint(f, c.String())
+	case 'x', 'X':
+		if f.Flag('#') {
+			if verb == 'X' {
+				fmt.Fprintf(f, "0x%08X", uint32(c))
+			} else {
+				fmt.Fprintf(f, "0x%08x", uint32(c))
+			}
+			return
+		}
+		format := "#%02x%02x%02x"
+		if verb == 'X' {
+			format = "#%02X%02X%02X"
+		}
+		s := fmt.Sprintf(format, c.Red(), c.Green(), c.Blue())
+		if f.Flag('+') {
+			s += fmt.Sprintf(format[1:5], c.Alpha())
+		}
+		fmt.Fprint(f, s)
+	case 'd':
+		fmt.Fprint(f, uint32(c))
+	default:
+		fmt.Fprintf(f, "%%!%c(ARGB=%s)", verb, c.HexARGB())
+	}
+}
+
+// Format implements fmt.Formatter.
+//
+//	%v, %s  same as String
+//	%+v     field names with values, e.g. Hct{Hue: 27.4, Chroma: 113.4, Tone: 53.2}
+//	%f      HCT(hue, chroma, tone) without color swatch. Precision controls
+//	        the number of decimals and defaults to 4
+func (h Hct) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprintf(f, "Hct{Hue: %v, Chroma: %v, Tone: %v}", h.Hue, h.Chroma, h.Tone)
+			return
+		}
+		fmt.Fprint(f, h.String())
+	case 's':
+		fmt.Fprint(f, h.String())
+	case 'f', 'F':
+		prec, ok := f.Precision()
+		if !ok {
+			prec = 4
+		}
+		format := func(v float64) string {
+			return strconv.FormatFloat(v, 'f', prec, 64)
+		}
+		fmt.Fprintf(f, "HCT(%s, %s, %s)", format(h.Hue), format(h.Chroma), format(h.Tone))
+	default:
+		fmt.Fprintf(f, "%%!%c(Hct=%v, %v, %v)", verb, h.Hue, h.Chroma, h.Tone)
+	}
+}
+
+// StringStyle controls how String methods of colors are rendered.
+type StringStyle int32
+
+const (
+	// SwatchHex renders hex value followed by a ANSI colored swatch.
+	SwatchHex StringStyle = iota
+	// PlainHex renders hex value without ANSI escape codes. Use this when
+	// output goes to logs or files.
+	PlainHex
+)
+
+var stringStyle atomic.Int32
+
+// SetStringStyle sets the style used by String methods of ARGB and Hct. It is
+// safe to call concurrently. The default is SwatchHex.
+func SetStringStyle(style StringStyle) {
+	stringStyle.Store(int32(style))
+}
+
+// GetStringStyle returns the style used by String methods.
+func GetStringStyle() StringStyle {
+	return StringStyle(stringStyle.Load())
+}
@@ -0,0 +1,86 @@
+package color
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestARGB_Format(t *testing.T) {
+	c := ARGB(0x80FF8000)
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%x", "#ff8000"},
+		{"%X", "#FF8000"},
+		{"%+x", "#ff800080"},
+		{"%+X", "#FF800080"},
+		{"%+v", "ARGB(a=128, r=255, g=128, b=0)"},
+		{"%d", "2164228096"},
+		{"%#x", "0x80ff8000"},
+		{"%#X", "0x80FF8000"},
+		{"%v", c.String()},
+		{"%s", c.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, c); got != tt.want {
+				t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestARGBFromUint32(t *testing.T) {
+	c := ARGBFromUint32(0x80FF8000)
+	if c.Alpha() != 0x80 || c.Red() != 0xFF || c.Green() != 0x80 || c.Blue() != 0 {
+		t.Errorf("ARGBFromUint32(0x80FF8000) = %s", c.HexARGB())
+	}
+	if got := c.Uint32(); got != 0x80FF8000 {
+		t.Errorf("Uint32() = %#08x, want 0x80ff8000", got)
+	}
+}
+
+func TestHct_Format(t *testing.T) {
+	h := Hct{Hue: 27.40817, Chroma: 113.35736, Tone: 53.23711}
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%f", "HCT(27.4082, 113.3574, 53.2371)"},
+		{"%.2f", "HCT(27.41, 113.36, 53.24)"},
+		{"%.0f", "HCT(27, 113, 53)"},
+		{"%+v", "Hct{Hue: 27.40817, Chroma: 113.35736, Tone: 53.23711}"},
+		{"%v", h.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, h); got != tt.want {
+				t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetStringStyle(t *testing.T) {
+	defer SetStringStyle(GetStringStyle())
+
+	c := ARGB(0xFFFF0000)
+	SetStringStyle(PlainHex)
+	if got := c.String(); got != "#FF0000" {
+		t.Errorf("String() = %q, want %q", got, "#FF0000")
+	}
+	if got := fmt.Sprint(c); got != "#FF0000" {
+		t.Errorf("Sprint() = %q, want %q", got, "#FF0000")
+	}
+	if got := (Hct{1, 2, 3}).String(); got != "HCT(1.0000, 2.0000, 3.0000)" {
+		t.Errorf("Hct.String() = %q", got)
+	}
+
+	SetStringStyle(SwatchHex)
+	if got, want := c.String(), c.Swatch(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
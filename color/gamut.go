@@ -0,0 +1,100 @@
+package color
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/num"
+)
+
+const (
+	// gamutMapJND is the just noticeable difference in ΔEOK used by the CSS
+	// Color 4 gamut mapping algorithm.
+	gamutMapJND = 0.02
+	// gamutMapEpsilon is the chroma precision of the binary search.
+	gamutMapEpsilon = 0.0001
+)
+
+// inSpaceGamut reports whether gamma encoded components are inside [0, 1].
+// Errors smaller than half of a 8-bit step are tolerated.
+func inSpaceGamut(r, g, b float64) bool {
+	const e = 0.5 / 255
+	return r >= -e && r <= 1+e && g >= -e && g <= 1+e && b >= -e && b <= 1+e
+}
+
+// GamutMapToSpace maps c into the gamut of space using the CSS Color 4 gamut
+// mapping algorithm. Chroma is reduced in OkLch, keeping lightness and hue,
+// until clipping the result is no longer noticeable. It returns the gamma
+// encoded components in space and whether c was out of gamut.
+//
+// See: https://www.w3.org/TR/css-color-4/#gamut-mapping
+func GamutMapToSpace(c OkLch, space *RGBColorSpace) (float64, float64, float64, bool) {
+	clip := func(r, g, b float64) (float64, float64, float64) {
+		return num.Clamp(0, 1, r), num.Clamp(0, 1, g), num.Clamp(0, 1, b)
+	}
+
+	if c.L >= 1 {
+		return 1, 1, 1, c.L > 1+gamutMapEpsilon || c.C > gamutMapJND
+	}
+	if c.L <= 0 {
+		return 0, 0, 0, c.L < -gamutMapEpsilon || c.C > gamutMapJND
+	}
+
+	r, g, b := space.FromXYZ(c.ToXYZ())
+	if inSpaceGamut(r, g, b) {
+		r, g, b = clip(r, g, b)
+		return r, g, b, false
+	}
+
+	// deltaClip clips current and returns the clipped color with its distance
+	// from current.
+	deltaClip := func(current OkLch) (float64, float64, float64, float64) {
+		r, g, b := clip(space.FromXYZ(current.ToXYZ()))
+		lab := OkLabFromXYZ(space.ToXYZ(r, g, b).Values())
+		return r, g, b, lab.DistanceOK(current.ToOkLab())
+	}
+
+	r, g, b, e := deltaClip(c)
+	if e < gamutMapJND {
+		return r, g, b, true
+	}
+
+	current := c
+	low, high := 0.0, c.C
+	lowInGamut := true
+	for high-low > gamutMapEpsilon {
+		current.C = (low + high) / 2
+		if lowInGamut && inSpaceGamut(space.FromXYZ(current.ToXYZ())) {
+			low = current.C
+			continue
+		}
+
+		r, g, b, e = deltaClip(current)
+		if e < gamutMapJND {
+			if gamutMapJND-e < gamutMapEpsilon {
+				return r, g, b, true
+			}
+			lowInGamut = false
+			low = current.C
+		} else {
+			high = current.C
+		}
+	}
+	return r, g, b, true
+}
+
+// GamutMap maps c into sRGB gamut using the CSS Color 4 gamut mapping
+// algorithm. It returns the mapped color and whether c was out of gamut.
+func GamutMap(c OkLch) (ARGB, bool) {
+	r, g, b, clipped := GamutMapToSpace(c, SRGBSpace)
+	return ARGBFromRGB(
+		uint8(math.Round(r*255)),
+		uint8(math.Round(g*255)),
+		uint8(math.Round(b*255)),
+	), clipped
+}
+
+// GamutMapXYZ maps a XYZ color, e.g. from a wide gamut RGB space, into sRGB
+// gamut. See GamutMap.
+func GamutMapXYZ(xyz XYZ) (ARGB, bool) {
+	return GamutMap(OkLabFromXYZ(xyz.Values()).ToOkLch())
+}
@@ -0,0 +1,70 @@
+package color
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/num"
+)
+
+func TestOkLab_RoundTrip(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			if got := OkLabFromARGB(tt.ARGB).ToARGB(); got != tt.ARGB {
+				t.Errorf("OkLab(%s) Round Trip = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+			if got := OkLchFromARGB(tt.ARGB).ToARGB(); got != tt.ARGB {
+				t.Errorf("OkLch(%s) Round Trip = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+		})
+	}
+}
+
+func TestGamutMap_InGamut(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			got, clipped := GamutMap(OkLchFromARGB(tt.ARGB))
+			if got != tt.ARGB || clipped {
+				t.Errorf("GamutMap(%s) = %s, %v, want %s, false", tt.ARGB.HexRGB(), got.HexRGB(), clipped, tt.ARGB.HexRGB())
+			}
+		})
+	}
+}
+
+func TestGamutMap_OutOfGamut(t *testing.T) {
+	tests := []struct {
+		name string
+		c    OkLch
+	}{
+		{"Vivid Green", OkLch{0.8, 0.4, 145}},
+		{"Vivid Blue", OkLch{0.5, 0.4, 260}},
+		{"Vivid Magenta", OkLch{0.7, 0.35, 330}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, clipped := GamutMap(tt.c)
+			if !clipped {
+				t.Errorf("GamutMap(%v) clipped = false, want true", tt.c)
+			}
+			// Lightness and hue should be roughly kept
+			lch := OkLchFromARGB(got)
+			if diff := lch.L - tt.c.L; diff > 0.03 || diff < -0.03 {
+				t.Errorf("GamutMap(%v) = %v, lightness changed too much", tt.c, lch)
+			}
+			if diff := num.DifferenceDegrees(lch.H, tt.c.H); diff > 5 {
+				t.Errorf("GamutMap(%v) = %v, hue changed too much", tt.c, lch)
+			}
+		})
+	}
+}
+
+func TestGamutMapXYZ_P3(t *testing.T) {
+	// Pure P3 red is outside sRGB
+	got, clipped := GamutMapXYZ(NewP3(1, 0, 0).ToXYZ())
+	if !clipped {
+		t.Errorf("GamutMapXYZ(P3 red) clipped = false, want true")
+	}
+	if got.Red() < 240 {
+		t.Errorf("GamutMapXYZ(P3 red) = %s, want a red", got.HexRGB())
+	}
+}
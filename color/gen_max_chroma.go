@@ -0,0 +1,51 @@
+//go:build ignore
+
+// gen_max_chroma generates max_chroma_table.go, the sRGB max chroma of HCT
+// colors on a grid of hue and tone.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"os"
+
+	"github.com/Nadim147c/material/color"
+)
+
+const (
+	hueStep  = 2
+	toneStep = 2
+)
+
+func main() {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_max_chroma.go; DO NOT EDIT.\n\n")
+	b.WriteString("package color\n\n")
+	fmt.Fprintf(&b, "const (\n\tmaxChromaHueStep = %d\n\tmaxChromaToneStep = %d\n)\n\n", hueStep, toneStep)
+	b.WriteString("// maxChromaTable holds MaxChroma rounded up to 0.1, indexed by hue and tone\n")
+	b.WriteString("// divided by their step.\n")
+	fmt.Fprintf(&b, "var maxChromaTable = [%d][%d]float32{\n", 360/hueStep+1, 100/toneStep+1)
+	for hue := 0; hue <= 360; hue += hueStep {
+		b.WriteString("\t{")
+		for tone := 0; tone <= 100; tone += toneStep {
+			chroma := math.Ceil(color.MaxChroma(float64(hue), float64(tone))*10) / 10
+			if tone > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%.1f", chroma)
+		}
+		fmt.Fprintf(&b, "}, // hue %d\n", hue)
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("max_chroma_table.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
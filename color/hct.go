@@ -3,6 +3,8 @@ package color
 import (
 	"fmt"
 	"math"
+
+	"github.com/Nadim147c/material/num"
 )
 
 // Hct represents a color in the HCT color space (Hue, Chroma, Tone).
@@ -10,9 +12,9 @@ import (
 // accurately render what colors will appear as in different lighting
 // environments.
 type Hct struct {
-	Hue    float64
-	Chroma float64
-	Tone   float64
+	Hue    float64 `json:"hue"`
+	Chroma float64 `json:"chroma"`
+	Tone   float64 `json:"tone"`
 }
 
 // Ensure Color implements the color.Color interface
@@ -25,12 +27,103 @@ var _ digitalColor = (*Hct)(nil)
 // tone.
 // tone: 0 <= tone <= 100; invalid values are corrected.
 func NewHct(hue, chroma, tone float64) Hct {
-	return solveToARGB(hue, chroma, tone).ToHct()
+	return solveHct(hue, chroma, tone).ToHct()
+}
+
+// MaxChroma returns the largest chroma that can be displayed in sRGB for the
+// given hue and tone. Requesting higher chroma from NewHct silently returns a
+// color with this chroma.
+func MaxChroma(hue, tone float64) float64 {
+	if tone < 0.0001 || tone > 99.9999 {
+		return 0
+	}
+	hueRadians := num.Radian(num.NormalizeDegree(hue))
+	linrgb := bisectToLimit(YFromLstar(tone), hueRadians)
+	x, y, z := SRGB_TO_XYZ.Multiply(linrgb).Values()
+	return Cam16FromXyzInEnv(XYZ{x, y, z}, &DefaultEnvironment).Chroma
+}
+
+// ClampChroma returns h with chroma reduced to MaxChroma of its hue and tone.
+// Hue and tone are kept as is.
+func (h Hct) ClampChroma() Hct {
+	h.Chroma = max(0, min(h.Chroma, MaxChroma(h.Hue, h.Tone)))
+	return h
+}
+
+// gamutTolerance is the chroma a color may exceed MaxChroma by and still be in
+// gamut. Colors rounded to 8 bit channels can be slightly above the limit.
+const gamutTolerance = 0.5
+
+// IsInSrgbGamut reports whether h can be displayed in sRGB as is. NewHct and
+// ToARGB silently reduce chroma of colors outside of the gamut.
+func (h Hct) IsInSrgbGamut() bool {
+	if h.Tone < 0 || h.Tone > 100 || h.Chroma < 0 {
+		return false
+	}
+	limit := MaxChroma(h.Hue, h.Tone)
+	if h.Tone < 0.0001 || h.Tone > 99.9999 {
+		// Only gray can be displayed, and white has a little chroma
+		limit = ARGBFromLstar(h.Tone).ToHct().Chroma
+	}
+	return h.Chroma <= limit+gamutTolerance
+}
+
+// GamutStrategy controls how ClampToGamut brings a color into sRGB. Hue is
+// kept by every strategy.
+type GamutStrategy int
+
+const (
+	// PreserveHue keeps hue and tone and reduces chroma, like NewHct does.
+	PreserveHue GamutStrategy = iota
+	// PreserveChroma keeps hue and chroma and moves tone to the closest tone
+	// that can display the chroma. If no tone can, the color with the most
+	// chroma of the hue is used.
+	PreserveChroma
+)
+
+// ClampToGamut returns h brought into sRGB with strategy. Tone is clamped to
+// [0, 100] and chroma to at least 0 first. Colors already in gamut are
+// returned as is.
+func (h Hct) ClampToGamut(strategy GamutStrategy) Hct {
+	h.Tone = num.Clamp(0, 100, h.Tone)
+	h.Chroma = max(0, h.Chroma)
+	if h.IsInSrgbGamut() {
+		return h
+	}
+
+	if strategy != PreserveChroma {
+		return h.ClampChroma()
+	}
+
+	// Find the tone with the most chroma, the cusp
+	cuspTone, cuspChroma := h.Tone, 0.0
+	for tone := 1.0; tone < 100; tone++ {
+		if c := MaxChroma(h.Hue, tone); c > cuspChroma {
+			cuspTone, cuspChroma = tone, c
+		}
+	}
+	if cuspChroma < h.Chroma {
+		return Hct{h.Hue, cuspChroma, cuspTone}
+	}
+
+	// Max chroma grows from h.Tone towards the cusp, bisect to the tone
+	// where it reaches the requested chroma
+	outside, inside := h.Tone, cuspTone
+	for range 20 {
+		mid := (outside + inside) / 2
+		if MaxChroma(h.Hue, mid) >= h.Chroma {
+			inside = mid
+		} else {
+			outside = mid
+		}
+	}
+	h.Tone = inside
+	return h
 }
 
 // ToInt returns the ARGB representation of this color.
 func (h Hct) ToARGB() ARGB {
-	return solveToARGB(h.Hue, h.Chroma, h.Tone)
+	return solveHct(h.Hue, h.Chroma, h.Tone)
 }
 
 // ToInt returns the ARGB representation of this color.
@@ -55,11 +148,15 @@ func (h Hct) ToCam() *Cam16 {
 
 // ToInt returns the ARGB representation of this color.
 func (h Hct) RGBA() (uint32, uint32, uint32, uint32) {
-	return solveToARGB(h.Hue, h.Chroma, h.Tone).RGBA()
+	return solveHct(h.Hue, h.Chroma, h.Tone).RGBA()
 }
 
-// String returns a string representation of the HCT color.
+// String returns a string representation of the HCT color. Depending on
+// StringStyle a ANSI color swatch is appended.
 func (h Hct) String() string {
+	if GetStringStyle() == PlainHex {
+		return fmt.Sprintf("HCT(%.4f, %.4f, %.4f)", h.Hue, h.Chroma, h.Tone)
+	}
 	return fmt.Sprintf("HCT(%.4f, %.4f, %.4f) %s", h.Hue, h.Chroma, h.Tone, h.ToARGB().AnsiBg("  "))
 }
 
@@ -131,8 +228,9 @@ func IsCyan(hue float64) bool {
 // calculate the appearance of a color in different settings. HCT is based on
 // CAM16, a color appearance model, and uses it to make these calculations.
 //
-// See ViewingConditions.Make for parameters affecting color appearance.
-func (h *Hct) InViewingConditions(env *Environmnet) Hct {
+// See NewEnvironment for parameters affecting color appearance, or use a
+// preset like DarkEnvironment or EnvironmentWithBackground.
+func (h *Hct) InViewingConditions(env *Environment) Hct {
 	cam := h.ToARGB().ToCam()
 	viewedInEnv := cam.Viewed(env)
 	newCam := viewedInEnv.ToCam()
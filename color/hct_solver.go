@@ -1,6 +1,7 @@
 package color
 
 import (
+	"cmp"
 	"math"
 
 	"github.com/Nadim147c/material/num"
@@ -222,8 +223,6 @@ func bisectToLimit(y float64, targetHue float64) num.Vector3 {
 			}
 
 			for range 8 {
-				color := NewXYZ(midpoint(left, right).Values()).ToARGB().String()
-				_ = color
 				if math.Abs(float64(rPlane-lPlane)) <= 1 {
 					break
 				} else {
@@ -257,19 +256,21 @@ func inverseChromaticAdaptation(adapted float64) float64 {
 // hueRadians: The desired hue in radians.
 // chroma: The desired chroma.
 // y: The desired Y.
+// opts: The iteration limit and Y tolerance of Newton method.
 // returns: The desired color as a hexadecimal integer, if found; 0 otherwise.
-func findResultByJ(hueRadians float64, chroma float64, y float64) ARGB {
+func findResultByJ(hueRadians float64, chroma float64, y float64, opts SolverOptions) ARGB {
 	// Initial estimate of j.
 	j := math.Sqrt(y) * 11.0
 
-	env := DefaultEnviroment
+	env := DefaultEnvironment
 	tInnerCoeff := 1 / math.Pow(1.64-math.Pow(0.29, env.N), 0.73)
 	eHue := 0.25 * (math.Cos(hueRadians+2.0) + 3.8)
 	p1 := eHue * (50000.0 / 13.0) * env.Nc * env.Ncb
 	hSin := math.Sin(hueRadians)
 	hCos := math.Cos(hueRadians)
 
-	for iterationRound := range 5 {
+	last := opts.MaxIterations - 1
+	for iterationRound := range opts.MaxIterations {
 		jNormalized := j / 100.0
 		alpha := chroma / math.Sqrt(jNormalized)
 		if chroma == 0.0 || j == 0.0 {
@@ -299,7 +300,7 @@ func findResultByJ(hueRadians float64, chroma float64, y float64) ARGB {
 		if fnj <= 0 {
 			return 0
 		}
-		if iterationRound == 4 || math.Abs(fnj-y) < 0.002 {
+		if iterationRound == last || math.Abs(fnj-y) < opts.Tolerance {
 			if linrgb[0] > 100.01 || linrgb[1] > 100.01 || linrgb[2] > 100.01 {
 				return 0
 			}
@@ -312,28 +313,55 @@ func findResultByJ(hueRadians float64, chroma float64, y float64) ARGB {
 	return 0
 }
 
+// SolverOptions controls the precision of SolveToARGB and SolveToCam. Fewer
+// iterations or a larger tolerance trade accuracy for speed. Zero fields use
+// the value of DefaultSolverOptions.
+type SolverOptions struct {
+	// MaxIterations is the maximum number of Newton iterations used to find
+	// the lightness J of the color.
+	MaxIterations int
+	// Tolerance is the largest accepted difference of Y, in [0, 100], between
+	// the found and the desired color.
+	Tolerance float64
+}
+
+// DefaultSolverOptions is the precision used by Hct.
+var DefaultSolverOptions = SolverOptions{MaxIterations: 5, Tolerance: 0.002}
+
 // SolveToARGB finds an sRGB color with the given hue, chroma, and L*, if
 // possible.
 //
 // hueDegrees: The desired hue, in degrees.
 // chroma: The desired chroma.
 // lstar: The desired L*.
+// opts: The precision of the solver.
 //
 // returns A hexadecimal representing the sRGB color. The color has sufficiently
 // close hue, chroma, and L* to the desired values, if possible; otherwise, the
 // hue and L* will be sufficiently close, and chroma will be maximized.
-func solveToARGB(hueDegrees float64, chroma float64, lstar float64) ARGB {
+func SolveToARGB(hueDegrees float64, chroma float64, lstar float64, opts SolverOptions) ARGB {
 	if chroma < 0.0001 || lstar < 0.0001 || lstar > 99.9999 {
 		return ARGBFromLstar(lstar)
 	}
 
+	opts.MaxIterations = cmp.Or(opts.MaxIterations, DefaultSolverOptions.MaxIterations)
+	opts.Tolerance = cmp.Or(opts.Tolerance, DefaultSolverOptions.Tolerance)
+
 	hueDegrees = num.NormalizeDegree(hueDegrees)
 	hueRadians := num.Radian(hueDegrees)
 	y := YFromLstar(lstar)
-	exactAnswer := findResultByJ(hueRadians, chroma, y)
-	if exactAnswer != 0 {
-		return exactAnswer
+	// Requests far outside of the gamut can not have an exact answer
+	if chroma <= chromaUpperBound(hueDegrees, lstar) {
+		exactAnswer := findResultByJ(hueRadians, chroma, y, opts)
+		if exactAnswer != 0 {
+			return exactAnswer
+		}
 	}
 	linrgb := bisectToLimit(y, hueRadians)
 	return ARGBFromLinRGB(linrgb.Values())
 }
+
+// SolveToCam is like SolveToARGB but returns the found color as Cam16.
+func SolveToCam(hueDegrees float64, chroma float64, lstar float64, opts SolverOptions) *Cam16 {
+	return SolveToARGB(hueDegrees, chroma, lstar, opts).ToCam()
+}
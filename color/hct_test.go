@@ -3,6 +3,8 @@ package color
 import (
 	"math"
 	"testing"
+
+	"github.com/Nadim147c/material/num"
 )
 
 func TestHct(t *testing.T) {
@@ -10,7 +12,7 @@ func TestHct(t *testing.T) {
 	originalHue := 180.0
 	originalChroma := 40.0
 
-	color := solveToARGB(originalHue, originalChroma, originalLstar)
+	color := SolveToARGB(originalHue, originalChroma, originalLstar, DefaultSolverOptions)
 	lstar2 := color.LStar()
 
 	if math.Abs(lstar2-originalLstar) > 0.1 {
@@ -18,6 +20,21 @@ func TestHct(t *testing.T) {
 	}
 }
 
+func TestSolverOptions(t *testing.T) {
+	for h := 0.0; h < 360; h += 30 {
+		want := SolveToARGB(h, 40, 60, DefaultSolverOptions)
+		if got := SolveToARGB(h, 40, 60, SolverOptions{}); got != want {
+			t.Errorf("SolveToARGB(%v) with zero options = %s, want %s", h, got.HexRGB(), want.HexRGB())
+		}
+
+		// Two iterations are less accurate but stays close
+		fast := SolveToCam(h, 40, 60, SolverOptions{MaxIterations: 2, Tolerance: 1})
+		if lstar := fast.ToARGB().LStar(); math.Abs(lstar-60) > 2 {
+			t.Errorf("SolveToCam(%v) fast L* = %.2f, want about 60", h, lstar)
+		}
+	}
+}
+
 func TestHctRoundTrip(t *testing.T) {
 	for _, tt := range ColorTestCases {
 		t.Run(tt.Name, func(t *testing.T) {
@@ -27,3 +44,120 @@ func TestHctRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestMaxChroma(t *testing.T) {
+	tests := []struct {
+		hue, tone float64
+	}{
+		{27, 50},
+		{140, 80},
+		{282, 30},
+		{90, 95},
+	}
+
+	for _, tt := range tests {
+		maxChroma := MaxChroma(tt.hue, tt.tone)
+		if maxChroma <= 0 {
+			t.Errorf("MaxChroma(%v, %v) = %f, want > 0", tt.hue, tt.tone, maxChroma)
+		}
+		// Requesting more chroma than possible must collapse to the boundary
+		got := NewHct(tt.hue, 200, tt.tone)
+		if math.Abs(got.Chroma-maxChroma) > 1 {
+			t.Errorf("NewHct(%v, 200, %v).Chroma = %f, want %f", tt.hue, tt.tone, got.Chroma, maxChroma)
+		}
+	}
+
+	if got := MaxChroma(120, 0); got != 0 {
+		t.Errorf("MaxChroma(120, 0) = %f, want 0", got)
+	}
+	if got := MaxChroma(120, 100); got != 0 {
+		t.Errorf("MaxChroma(120, 100) = %f, want 0", got)
+	}
+}
+
+func TestHctClampChroma(t *testing.T) {
+	h := Hct{Hue: 200, Chroma: 150, Tone: 50}.ClampChroma()
+	if h.Hue != 200 || h.Tone != 50 {
+		t.Errorf("ClampChroma() changed hue or tone: %v", h)
+	}
+	if want := MaxChroma(200, 50); h.Chroma != want {
+		t.Errorf("ClampChroma().Chroma = %f, want %f", h.Chroma, want)
+	}
+
+	low := Hct{Hue: 200, Chroma: 10, Tone: 50}
+	if got := low.ClampChroma(); got != low {
+		t.Errorf("ClampChroma() = %v, want %v", got, low)
+	}
+}
+
+func TestChromaUpperBound(t *testing.T) {
+	for hue := 0.0; hue < 360; hue += 1.3 {
+		for tone := 0.5; tone < 100; tone += 0.7 {
+			bound := chromaUpperBound(hue, tone)
+			if got := MaxChroma(hue, tone); got > bound {
+				t.Fatalf("MaxChroma(%v, %v) = %.2f, above bound %.2f", hue, tone, got, bound)
+			}
+			// No exact answer exists above the bound
+			y := YFromLstar(tone)
+			if got := findResultByJ(num.Radian(hue), bound, y, DefaultSolverOptions); got != 0 {
+				t.Fatalf("findResultByJ(%v, %.2f, %v) = %s, want 0", hue, bound, tone, got.HexRGB())
+			}
+		}
+	}
+}
+
+func TestHct_IsInSrgbGamut(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		if h := tt.ARGB.ToHct(); !h.IsInSrgbGamut() {
+			t.Errorf("%s.ToHct() = %v, want in gamut", tt.ARGB.HexRGB(), h)
+		}
+	}
+
+	for _, h := range []Hct{{270, 120, 50}, {140, 100, 20}, {30, 10, 101}, {30, -1, 50}} {
+		if h.IsInSrgbGamut() {
+			t.Errorf("Hct%v is in gamut, want out of gamut", h)
+		}
+	}
+}
+
+func TestHct_ClampToGamut(t *testing.T) {
+	in := Hct{200, 20, 60}
+	for _, strategy := range []GamutStrategy{PreserveHue, PreserveChroma} {
+		if got := in.ClampToGamut(strategy); got != in {
+			t.Errorf("ClampToGamut(%d) of in gamut color = %v, want %v", strategy, got, in)
+		}
+	}
+
+	// Blue can reach chroma 60 only at darker tones
+	out := Hct{282, 60, 80}
+	got := out.ClampToGamut(PreserveHue)
+	if got.Hue != out.Hue || got.Tone != out.Tone || got.Chroma >= out.Chroma || !got.IsInSrgbGamut() {
+		t.Errorf("ClampToGamut(PreserveHue) = %v, want lower chroma at tone 80", got)
+	}
+	got = out.ClampToGamut(PreserveChroma)
+	if got.Hue != out.Hue || got.Chroma != out.Chroma || got.Tone >= out.Tone || !got.IsInSrgbGamut() {
+		t.Errorf("ClampToGamut(PreserveChroma) = %v, want chroma 60 at a darker tone", got)
+	}
+	if c := MaxChroma(got.Hue, got.Tone+1); c >= out.Chroma {
+		t.Errorf("ClampToGamut(PreserveChroma) tone %.2f is not the closest", got.Tone)
+	}
+
+	// No tone reaches the chroma, the cusp is used
+	got = Hct{282, 200, 50}.ClampToGamut(PreserveChroma)
+	if !got.IsInSrgbGamut() || got.Chroma < 80 {
+		t.Errorf("ClampToGamut(PreserveChroma) = %v, want the cusp of blue", got)
+	}
+}
+
+func TestChromaUpperBound_Edges(t *testing.T) {
+	// NormalizeDegree returns exactly 360 for tiny negative hues
+	for _, hue := range []float64{-1e-14, 360} {
+		want := NewHct(0, 50, 50)
+		if got := NewHct(hue, 50, 50); got != want {
+			t.Errorf("NewHct(%v, 50, 50) = %v, want %v", hue, got, want)
+		}
+	}
+	if bound := chromaUpperBound(360, 100); bound <= 0 {
+		t.Errorf("chromaUpperBound(360, 100) = %v, want positive", bound)
+	}
+}
@@ -0,0 +1,166 @@
+package color
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/num"
+)
+
+// HSL is a sRGB color in hue, saturation, lightness form as used by CSS. H is
+// hue in degrees, S and L are in range [0, 100].
+type HSL struct {
+	H, S, L float64
+}
+
+var _ digitalColor = (*HSL)(nil)
+
+// HWB is a sRGB color in hue, whiteness, blackness form as used by CSS. H is
+// hue in degrees, W and B are in range [0, 100].
+type HWB struct {
+	H, W, B float64
+}
+
+var _ digitalColor = (*HWB)(nil)
+
+// rgbHue returns hue in degrees, and min and max component of gamma encoded
+// sRGB components in range [0, 1].
+func rgbHue(r, g, b float64) (hue, lo, hi float64) {
+	lo, hi = min(r, g, b), max(r, g, b)
+	d := hi - lo
+	if d == 0 {
+		return 0, lo, hi
+	}
+	switch hi {
+	case r:
+		hue = (g-b)/d + 6
+	case g:
+		hue = (b-r)/d + 2
+	default:
+		hue = (r-g)/d + 4
+	}
+	return num.NormalizeDegree(hue * 60), lo, hi
+}
+
+// hslToRGB converts HSL with s and l in [0, 1] to gamma encoded sRGB.
+func hslToRGB(h, s, l float64) (float64, float64, float64) {
+	h = num.NormalizeDegree(h)
+	a := s * min(l, 1-l)
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/30, 12)
+		return l - a*max(-1, min(k-3, 9-k, 1))
+	}
+	return f(0), f(8), f(4)
+}
+
+// NewHSL creates a HSL color.
+func NewHSL(h, s, l float64) HSL {
+	return HSL{h, s, l}
+}
+
+// HSLFromARGB converts a ARGB color to HSL.
+func HSLFromARGB(c ARGB) HSL {
+	r, g, b := float64(c.Red())/255, float64(c.Green())/255, float64(c.Blue())/255
+	h, lo, hi := rgbHue(r, g, b)
+	l := (lo + hi) / 2
+	s := 0.0
+	if l > 0 && l < 1 {
+		s = (hi - l) / min(l, 1-l)
+	}
+	return HSL{h, s * 100, l * 100}
+}
+
+// Values returns H, S, L values of HSL color
+func (c HSL) Values() (float64, float64, float64) {
+	return c.H, c.S, c.L
+}
+
+// rgb returns gamma encoded sRGB components of c.
+func (c HSL) rgb() (float64, float64, float64) {
+	s := num.Clamp(0, 1, c.S/100)
+	l := num.Clamp(0, 1, c.L/100)
+	return hslToRGB(c.H, s, l)
+}
+
+func (c HSL) ToARGB() ARGB {
+	r, g, b := c.rgb()
+	return ARGBFromRGB(to8Bit(r), to8Bit(g), to8Bit(b))
+}
+
+func (c HSL) ToXYZ() XYZ {
+	return SRGBSpace.ToXYZ(c.rgb())
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c HSL) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c HSL) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c HSL) ToHct() Hct {
+	return c.ToARGB().ToHct()
+}
+
+func (c HSL) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
+
+// NewHWB creates a HWB color.
+func NewHWB(h, w, b float64) HWB {
+	return HWB{h, w, b}
+}
+
+// HWBFromARGB converts a ARGB color to HWB.
+func HWBFromARGB(c ARGB) HWB {
+	r, g, b := float64(c.Red())/255, float64(c.Green())/255, float64(c.Blue())/255
+	h, lo, hi := rgbHue(r, g, b)
+	return HWB{h, lo * 100, (1 - hi) * 100}
+}
+
+// Values returns H, W, B values of HWB color
+func (c HWB) Values() (float64, float64, float64) {
+	return c.H, c.W, c.B
+}
+
+// rgb returns gamma encoded sRGB components of c.
+func (c HWB) rgb() (float64, float64, float64) {
+	w := num.Clamp(0, 1, c.W/100)
+	b := num.Clamp(0, 1, c.B/100)
+	if w+b >= 1 {
+		gray := w / (w + b)
+		return gray, gray, gray
+	}
+	r, g, bl := hslToRGB(c.H, 1, 0.5)
+	scale := 1 - w - b
+	return r*scale + w, g*scale + w, bl*scale + w
+}
+
+func (c HWB) ToARGB() ARGB {
+	r, g, b := c.rgb()
+	return ARGBFromRGB(to8Bit(r), to8Bit(g), to8Bit(b))
+}
+
+func (c HWB) ToXYZ() XYZ {
+	return SRGBSpace.ToXYZ(c.rgb())
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c HWB) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c HWB) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c HWB) ToHct() Hct {
+	return c.ToARGB().ToHct()
+}
+
+func (c HWB) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
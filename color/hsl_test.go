@@ -0,0 +1,46 @@
+package color
+
+import "testing"
+
+func TestHSL_FromARGB(t *testing.T) {
+	tests := []struct {
+		name string
+		argb ARGB
+		hsl  HSL
+		hwb  HWB
+	}{
+		{"White", 0xFFFFFFFF, HSL{0, 0, 100}, HWB{0, 100, 0}},
+		{"Black", 0xFF000000, HSL{0, 0, 0}, HWB{0, 0, 100}},
+		{"Red", 0xFFFF0000, HSL{0, 100, 50}, HWB{0, 0, 0}},
+		{"Teal", 0xFF008080, HSL{180, 100, 25.0980}, HWB{180, 0, 49.8039}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hsl := HSLFromARGB(tt.argb)
+			if !almostEqual(hsl.H, tt.hsl.H) || !almostEqual(hsl.S, tt.hsl.S) || !almostEqual(hsl.L, tt.hsl.L) {
+				t.Errorf("HSLFromARGB(%s) = %v, want %v", tt.argb.HexRGB(), hsl, tt.hsl)
+			}
+			hwb := HWBFromARGB(tt.argb)
+			if !almostEqual(hwb.H, tt.hwb.H) || !almostEqual(hwb.W, tt.hwb.W) || !almostEqual(hwb.B, tt.hwb.B) {
+				t.Errorf("HWBFromARGB(%s) = %v, want %v", tt.argb.HexRGB(), hwb, tt.hwb)
+			}
+		})
+	}
+}
+
+func TestHSL_RoundTrip(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			if got := HSLFromARGB(tt.ARGB).ToARGB(); got != tt.ARGB {
+				t.Errorf("HSL(%s) Round Trip = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+			if got := HWBFromARGB(tt.ARGB).ToARGB(); got != tt.ARGB {
+				t.Errorf("HWB(%s) Round Trip = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+			if got := HSLFromARGB(tt.ARGB).ToXYZ().ToARGB(); got != tt.ARGB {
+				t.Errorf("HSL(%s).ToXYZ() = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package color
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/num"
+)
+
+// HSLuv is a color in HSLuv color space, a human friendly alternative to HSL
+// built on CIELUV. H is hue in degrees, S and L are in range [0, 100]. Every
+// HSLuv value with S in [0, 100] is inside the sRGB gamut.
+type HSLuv struct {
+	H, S, L float64
+}
+
+var _ digitalColor = (*HSLuv)(nil)
+
+// HPLuv is a variant of HSLuv where P is limited to chroma that is available
+// for every hue at the given lightness. This makes it pastel only but keeps
+// chroma uniform across hues when P <= 100.
+type HPLuv struct {
+	H, P, L float64
+}
+
+var _ digitalColor = (*HPLuv)(nil)
+
+// gamutLine is a line in the chroma plane of LChuv in form y = slope*x +
+// intercept.
+type gamutLine struct {
+	slope, intercept float64
+}
+
+// gamutBounds returns the six lines bounding the sRGB gamut in the u, v plane
+// at the given lightness.
+func gamutBounds(l float64) [6]gamutLine {
+	var lines [6]gamutLine
+
+	sub1 := math.Pow(l+16, 3) / 1560896
+	sub2 := sub1
+	if sub1 <= LabFuncE {
+		sub2 = l / LabFuncK
+	}
+
+	for c := range 3 {
+		m1, m2, m3 := XYZ_TO_SRGB[c].Values()
+		for t := range 2 {
+			top1 := (284517*m1 - 94839*m3) * sub2
+			top2 := (838422*m3+769860*m2+731718*m1)*l*sub2 - 769860*float64(t)*l
+			bottom := (632260*m3-126452*m2)*sub2 + 126452*float64(t)
+			lines[c*2+t] = gamutLine{top1 / bottom, top2 / bottom}
+		}
+	}
+	return lines
+}
+
+// maxChromaForLH returns the maximum LChuv chroma inside sRGB gamut for given
+// lightness and hue.
+func maxChromaForLH(l, h float64) float64 {
+	hRad := num.Radian(h)
+	minLength := math.MaxFloat64
+	for _, line := range gamutBounds(l) {
+		length := line.intercept / (math.Sin(hRad) - line.slope*math.Cos(hRad))
+		if length >= 0 {
+			minLength = min(minLength, length)
+		}
+	}
+	return minLength
+}
+
+// maxSafeChromaForL returns the maximum LChuv chroma inside sRGB gamut for
+// every hue at the given lightness.
+func maxSafeChromaForL(l float64) float64 {
+	minLength := math.MaxFloat64
+	for _, line := range gamutBounds(l) {
+		length := math.Abs(line.intercept) / math.Sqrt(line.slope*line.slope+1)
+		minLength = min(minLength, length)
+	}
+	return minLength
+}
+
+// NewHSLuv creates a HSLuv color.
+func NewHSLuv(h, s, l float64) HSLuv {
+	return HSLuv{h, s, l}
+}
+
+// HSLuvFromLChuv converts a LChuv color to HSLuv.
+func HSLuvFromLChuv(c LChuv) HSLuv {
+	if c.L > 99.9999999 {
+		return HSLuv{c.H, 0, 100}
+	}
+	if c.L < 0.00000001 {
+		return HSLuv{c.H, 0, 0}
+	}
+	return HSLuv{c.H, c.C / maxChromaForLH(c.L, c.H) * 100, c.L}
+}
+
+// HSLuvFromARGB converts a ARGB color to HSLuv.
+func HSLuvFromARGB(c ARGB) HSLuv {
+	return HSLuvFromLChuv(LuvFromARGB(c).ToLChuv())
+}
+
+// Values returns H, S, L values of HSLuv color
+func (c HSLuv) Values() (float64, float64, float64) {
+	return c.H, c.S, c.L
+}
+
+// ToLChuv converts c to LChuv.
+func (c HSLuv) ToLChuv() LChuv {
+	if c.L > 99.9999999 {
+		return LChuv{100, 0, c.H}
+	}
+	if c.L < 0.00000001 {
+		return LChuv{0, 0, c.H}
+	}
+	return LChuv{c.L, maxChromaForLH(c.L, c.H) / 100 * c.S, c.H}
+}
+
+func (c HSLuv) ToXYZ() XYZ {
+	return c.ToLChuv().ToXYZ()
+}
+
+func (c HSLuv) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c HSLuv) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c HSLuv) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c HSLuv) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c HSLuv) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
+
+// NewHPLuv creates a HPLuv color.
+func NewHPLuv(h, p, l float64) HPLuv {
+	return HPLuv{h, p, l}
+}
+
+// HPLuvFromLChuv converts a LChuv color to HPLuv.
+func HPLuvFromLChuv(c LChuv) HPLuv {
+	if c.L > 99.9999999 {
+		return HPLuv{c.H, 0, 100}
+	}
+	if c.L < 0.00000001 {
+		return HPLuv{c.H, 0, 0}
+	}
+	return HPLuv{c.H, c.C / maxSafeChromaForL(c.L) * 100, c.L}
+}
+
+// HPLuvFromARGB converts a ARGB color to HPLuv.
+func HPLuvFromARGB(c ARGB) HPLuv {
+	return HPLuvFromLChuv(LuvFromARGB(c).ToLChuv())
+}
+
+// Values returns H, P, L values of HPLuv color
+func (c HPLuv) Values() (float64, float64, float64) {
+	return c.H, c.P, c.L
+}
+
+// ToLChuv converts c to LChuv.
+func (c HPLuv) ToLChuv() LChuv {
+	if c.L > 99.9999999 {
+		return LChuv{100, 0, c.H}
+	}
+	if c.L < 0.00000001 {
+		return LChuv{0, 0, c.H}
+	}
+	return LChuv{c.L, maxSafeChromaForL(c.L) / 100 * c.P, c.H}
+}
+
+func (c HPLuv) ToXYZ() XYZ {
+	return c.ToLChuv().ToXYZ()
+}
+
+func (c HPLuv) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c HPLuv) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c HPLuv) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c HPLuv) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c HPLuv) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
@@ -0,0 +1,62 @@
+package color
+
+import "testing"
+
+func TestLuv_FromARGB(t *testing.T) {
+	tests := []struct {
+		name string
+		argb ARGB
+		want Luv
+	}{
+		{"White", 0xFFFFFFFF, Luv{100, 0, 0}},
+		{"Black", 0xFF000000, Luv{0, 0, 0}},
+		{"Red", 0xFFFF0000, Luv{53.2371, 175.0151, 37.7564}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LuvFromARGB(tt.argb)
+			if !almostEqual(got.L, tt.want.L) || !almostEqual(got.U, tt.want.U) ||
+				!almostEqual(got.V, tt.want.V) {
+				t.Errorf("LuvFromARGB(%s) = %v, want %v", tt.argb.HexRGB(), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHSLuv_FromARGB(t *testing.T) {
+	red := HSLuvFromARGB(0xFFFF0000)
+	if !almostEqual(red.H, 12.1770) || !almostEqual(red.S, 100) || !almostEqual(red.L, 53.2371) {
+		t.Errorf("HSLuvFromARGB(red) = %v, want {12.1770 100 53.2371}", red)
+	}
+
+	white := HSLuvFromARGB(0xFFFFFFFF)
+	if !almostEqual(white.S, 0) || !almostEqual(white.L, 100) {
+		t.Errorf("HSLuvFromARGB(white) = %v, want S=0 L=100", white)
+	}
+}
+
+func TestHSLuv_RoundTrip(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			if got := HSLuvFromARGB(tt.ARGB).ToARGB(); got != tt.ARGB {
+				t.Errorf("HSLuv(%s) Round Trip = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+			if got := HPLuvFromARGB(tt.ARGB).ToARGB(); got != tt.ARGB {
+				t.Errorf("HPLuv(%s) Round Trip = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+		})
+	}
+}
+
+func TestHSLuv_InGamut(t *testing.T) {
+	// Full saturation must stay in gamut for every hue
+	for h := 0.0; h < 360; h += 15 {
+		lch := NewHSLuv(h, 100, 60).ToLChuv()
+		lin := LinRGBFromXYZ(lch.ToXYZ())
+		if lin.R < -0.001 || lin.R > 1.001 || lin.G < -0.001 || lin.G > 1.001 ||
+			lin.B < -0.001 || lin.B > 1.001 {
+			t.Errorf("HSLuv(%v, 100, 60) is out of gamut: %v", h, lin)
+		}
+	}
+}
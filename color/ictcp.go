@@ -0,0 +1,115 @@
+package color
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/num"
+)
+
+var (
+	ICtCpRec2020ToLMS = num.NewMatrix3(
+		1688.0/4096, 2146.0/4096, 262.0/4096,
+		683.0/4096, 2951.0/4096, 462.0/4096,
+		99.0/4096, 309.0/4096, 3688.0/4096,
+	)
+
+	ICtCpLMSToRec2020 = num.NewMatrix3(
+		3.4366066943330784, -2.50645211865627, 0.06984542432319148,
+		-0.7913295555989287, 1.9836004517922907, -0.192270896193362,
+		-0.025949899690592672, -0.09891371471172644, 1.1248636144023192,
+	)
+
+	ICtCpLMSToICtCp = num.NewMatrix3(
+		0.5, 0.5, 0,
+		6610.0/4096, -13613.0/4096, 7003.0/4096,
+		17933.0/4096, -17390.0/4096, -543.0/4096,
+	)
+
+	ICtCpICtCpToLMS = num.NewMatrix3(
+		1.0, 0.008609037037932756, 0.11102962500302596,
+		1.0, -0.008609037037932756, -0.11102962500302596,
+		1.0, 0.5600313357106791, -0.32062717498731885,
+	)
+)
+
+// SDRWhiteLuminance is the luminance in cd/m² that XYZ Y=100 (diffuse white)
+// is mapped to when converting to and from HDR color spaces. The default
+// follows ITU-R BT.2408.
+var SDRWhiteLuminance = 203.0
+
+// ICtCp is a color in ITU-R BT.2100 ICtCp color space using the PQ transfer
+// function. I is intensity and Ct, Cp are the blue-yellow and red-green
+// chroma components.
+type ICtCp struct {
+	I, Ct, Cp float64
+}
+
+var _ digitalColor = (*ICtCp)(nil)
+
+// NewICtCp creates a ICtCp color.
+func NewICtCp(i, ct, cp float64) ICtCp {
+	return ICtCp{i, ct, cp}
+}
+
+// ICtCpFromXYZ converts a XYZ color to ICtCp. XYZ Y=100 is mapped to
+// SDRWhiteLuminance.
+func ICtCpFromXYZ(xyz XYZ) ICtCp {
+	rgb := num.NewVector3(Rec2020Space.LinearFromXYZ(xyz))
+	lms := ICtCpRec2020ToLMS.Multiply(rgb).MultiplyScalar(SDRWhiteLuminance / 10000)
+	for i := range lms {
+		lms[i] = pqEncode(lms[i])
+	}
+	i, ct, cp := ICtCpLMSToICtCp.Multiply(lms).Values()
+	return ICtCp{i, ct, cp}
+}
+
+// ICtCpFromARGB converts a ARGB color to ICtCp.
+func ICtCpFromARGB(c ARGB) ICtCp {
+	return ICtCpFromXYZ(c.ToXYZ())
+}
+
+// Values returns I, Ct, Cp values of ICtCp color
+func (c ICtCp) Values() (float64, float64, float64) {
+	return c.I, c.Ct, c.Cp
+}
+
+// DeltaE returns the ΔE ITP color difference (ITU-R BT.2124) between c and
+// other. A value of 1 is about one just noticeable difference.
+func (c ICtCp) DeltaE(other ICtCp) float64 {
+	dI := c.I - other.I
+	dT := 0.5 * (c.Ct - other.Ct)
+	dP := c.Cp - other.Cp
+	return 720 * math.Sqrt(dI*dI+dT*dT+dP*dP)
+}
+
+// ToXYZ returns XYZ color version for c.
+func (c ICtCp) ToXYZ() XYZ {
+	lms := ICtCpICtCpToLMS.MultiplyXYZ(c.Values())
+	for i := range lms {
+		lms[i] = pqDecode(lms[i])
+	}
+	rgb := ICtCpLMSToRec2020.Multiply(lms).MultiplyScalar(10000 / SDRWhiteLuminance)
+	return Rec2020Space.LinearToXYZ(rgb.Values())
+}
+
+func (c ICtCp) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c ICtCp) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c ICtCp) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c ICtCp) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c ICtCp) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
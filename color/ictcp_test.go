@@ -0,0 +1,32 @@
+package color
+
+import "testing"
+
+func TestICtCp_White(t *testing.T) {
+	ictcp := ICtCpFromARGB(0xFFFFFFFF)
+	// PQ code value of 203 cd/m² is ~0.58
+	if !almostEqual(ictcp.I, 0.58) || !almostEqual(ictcp.Ct, 0) || !almostEqual(ictcp.Cp, 0) {
+		t.Errorf("ICtCpFromARGB(white) = %v, want {0.58 0 0}", ictcp)
+	}
+}
+
+func TestICtCp_RoundTrip(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			if got := ICtCpFromARGB(tt.ARGB).ToARGB(); got != tt.ARGB {
+				t.Errorf("ICtCp(%s) Round Trip = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+		})
+	}
+}
+
+func TestICtCp_DeltaE(t *testing.T) {
+	a := ICtCpFromARGB(0xFF808080)
+	b := ICtCpFromARGB(0xFF818181)
+	if got := a.DeltaE(a); got != 0 {
+		t.Errorf("DeltaE(a, a) = %f, want 0", got)
+	}
+	if got := a.DeltaE(b); got <= 0 || got > 2 {
+		t.Errorf("DeltaE(#808080, #818181) = %f, want (0, 2]", got)
+	}
+}
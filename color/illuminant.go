@@ -0,0 +1,41 @@
+package color
+
+// Standard illuminants of the CIE 1931 2° observer as XYZ white points with
+// Y = 100. Values are from ASTM E308.
+var (
+	// IlluminantA is a tungsten filament lamp of 2856 K.
+	IlluminantA = XYZ{109.850, 100, 35.585}
+	// IlluminantC is average daylight, superseded by D65.
+	IlluminantC = XYZ{98.074, 100, 118.232}
+	// IlluminantD50 is horizon light, used by ICC profiles and print.
+	IlluminantD50 = XYZ{96.422, 100, 82.521}
+	// IlluminantD55 is mid-morning or mid-afternoon daylight.
+	IlluminantD55 = XYZ{95.682, 100, 92.149}
+	// IlluminantD65 is noon daylight, the white point of sRGB and this package.
+	IlluminantD65 = XYZ{95.047, 100, 108.883}
+	// IlluminantD75 is north sky daylight.
+	IlluminantD75 = XYZ{94.972, 100, 122.638}
+	// IlluminantE is the equal energy illuminant.
+	IlluminantE = XYZ{100, 100, 100}
+	// IlluminantF2 is a cool white fluorescent lamp.
+	IlluminantF2 = XYZ{99.187, 100, 67.395}
+	// IlluminantF7 is a broadband daylight fluorescent lamp.
+	IlluminantF7 = XYZ{95.044, 100, 108.755}
+	// IlluminantF11 is a narrow band white fluorescent lamp.
+	IlluminantF11 = XYZ{100.966, 100, 64.370}
+)
+
+// Illuminants maps lowercase names like "d65" and "f11" to the standard
+// illuminants of this package.
+var Illuminants = map[string]XYZ{
+	"a":   IlluminantA,
+	"c":   IlluminantC,
+	"d50": IlluminantD50,
+	"d55": IlluminantD55,
+	"d65": IlluminantD65,
+	"d75": IlluminantD75,
+	"e":   IlluminantE,
+	"f2":  IlluminantF2,
+	"f7":  IlluminantF7,
+	"f11": IlluminantF11,
+}
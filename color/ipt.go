@@ -0,0 +1,109 @@
+package color
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/num"
+)
+
+var (
+	IptXYZToLMS = num.NewMatrix3(
+		0.4002, 0.7075, -0.0807,
+		-0.2280, 1.1500, 0.0612,
+		0.0, 0.0, 0.9184,
+	)
+
+	IptLMSToXYZ = num.NewMatrix3(
+		1.8502429449432056, -1.138301637867233, 0.23843495850870133,
+		0.3668307751713486, 0.6438845448402355, -0.010673443584379992,
+		0.0, 0.0, 1.088850174216028,
+	)
+
+	IptLMSToIPT = num.NewMatrix3(
+		0.4000, 0.4000, 0.2000,
+		4.4550, -4.8510, 0.3960,
+		0.8056, 0.3572, -1.1628,
+	)
+
+	IptIPTToLMS = num.NewMatrix3(
+		1.0, 0.09756893051461392, 0.2052264331645916,
+		1.0, -0.11387648547314712, 0.13321715836999806,
+		1.0, 0.03261510991706641, -0.6768871830691794,
+	)
+)
+
+// IPT is a color in IPT color space by Ebner and Fairchild. I is lightness
+// (0-1 for sRGB colors), P is red-green and T is yellow-blue opponent axis.
+// IPT has good hue linearity which makes it useful for gamut mapping.
+type IPT struct {
+	I, P, T float64
+}
+
+var _ digitalColor = (*IPT)(nil)
+
+// NewIPT creates a IPT color.
+func NewIPT(i, p, t float64) IPT {
+	return IPT{i, p, t}
+}
+
+// IPTFromXYZ converts a D65 referenced XYZ color to IPT.
+func IPTFromXYZ(xyz XYZ) IPT {
+	lms := IptXYZToLMS.MultiplyXYZ(xyz.Values()).MultiplyScalar(0.01)
+	for i := range lms {
+		lms[i] = math.Copysign(math.Pow(math.Abs(lms[i]), 0.43), lms[i])
+	}
+	i, p, t := IptLMSToIPT.Multiply(lms).Values()
+	return IPT{i, p, t}
+}
+
+// IPTFromARGB converts a ARGB color to IPT.
+func IPTFromARGB(c ARGB) IPT {
+	return IPTFromXYZ(c.ToXYZ())
+}
+
+// Values returns I, P, T values of IPT color
+func (c IPT) Values() (float64, float64, float64) {
+	return c.I, c.P, c.T
+}
+
+// Chroma returns the distance of c from the neutral axis.
+func (c IPT) Chroma() float64 {
+	return math.Hypot(c.P, c.T)
+}
+
+// Hue returns the hue angle of c in degrees.
+func (c IPT) Hue() float64 {
+	return num.NormalizeDegree(num.Degree(math.Atan2(c.T, c.P)))
+}
+
+// ToXYZ returns XYZ color version for c.
+func (c IPT) ToXYZ() XYZ {
+	lms := IptIPTToLMS.MultiplyXYZ(c.Values())
+	for i := range lms {
+		lms[i] = math.Copysign(math.Pow(math.Abs(lms[i]), 1/0.43), lms[i])
+	}
+	x, y, z := IptLMSToXYZ.Multiply(lms).MultiplyScalar(100).Values()
+	return XYZ{x, y, z}
+}
+
+func (c IPT) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c IPT) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c IPT) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c IPT) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c IPT) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
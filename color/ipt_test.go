@@ -0,0 +1,20 @@
+package color
+
+import "testing"
+
+func TestIPT_White(t *testing.T) {
+	ipt := IPTFromARGB(0xFFFFFFFF)
+	if !almostEqual(ipt.I, 1) || !almostEqual(ipt.P, 0) || !almostEqual(ipt.T, 0) {
+		t.Errorf("IPTFromARGB(white) = %v, want {1 0 0}", ipt)
+	}
+}
+
+func TestIPT_RoundTrip(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			if got := IPTFromARGB(tt.ARGB).ToARGB(); got != tt.ARGB {
+				t.Errorf("IPT(%s) Round Trip = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+		})
+	}
+}
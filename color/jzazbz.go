@@ -0,0 +1,201 @@
+package color
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/num"
+)
+
+var (
+	JzazbzXYZToLMS = num.NewMatrix3(
+		0.41478972, 0.579999, 0.0146480,
+		-0.2015100, 1.120649, 0.0531008,
+		-0.0166008, 0.264800, 0.6684799,
+	)
+
+	JzazbzLMSToXYZ = num.NewMatrix3(
+		1.924226435787607, -1.004792312595366, 0.037651404030618014,
+		0.3503167620949992, 0.7264811939316554, -0.06538442294808504,
+		-0.09098281098284759, -0.31272829052307405, 1.5227665613052608,
+	)
+
+	JzazbzLMSToIab = num.NewMatrix3(
+		0.5, 0.5, 0,
+		3.524000, -4.066708, 0.542708,
+		0.199076, 1.096799, -1.295875,
+	)
+
+	JzazbzIabToLMS = num.NewMatrix3(
+		1.0, 0.13860504327153927, 0.058047316156118856,
+		1.0, -0.13860504327153927, -0.058047316156118856,
+		1.0, -0.09601924202631894, -0.8118918960560388,
+	)
+)
+
+const (
+	jzazbzB  = 1.15
+	jzazbzG  = 0.66
+	jzazbzD  = -0.56
+	jzazbzD0 = 1.6295499532821566e-11
+	jzazbzP  = 1.7 * 2523.0 / 32.0
+)
+
+// Jzazbz is a color in Jzazbz color space by Safdar et al. Jzazbz is
+// perceptually uniform for both SDR and HDR luminance levels. XYZ Y=100 is
+// mapped to SDRWhiteLuminance.
+type Jzazbz struct {
+	Jz, Az, Bz float64
+}
+
+var _ digitalColor = (*Jzazbz)(nil)
+
+// NewJzazbz creates a Jzazbz color.
+func NewJzazbz(jz, az, bz float64) Jzazbz {
+	return Jzazbz{jz, az, bz}
+}
+
+// JzazbzFromXYZ converts a D65 referenced XYZ color to Jzazbz.
+func JzazbzFromXYZ(xyz XYZ) Jzazbz {
+	scale := SDRWhiteLuminance / 100
+	x, y, z := xyz.X*scale, xyz.Y*scale, xyz.Z*scale
+
+	xp := jzazbzB*x - (jzazbzB-1)*z
+	yp := jzazbzG*y - (jzazbzG-1)*x
+
+	lms := JzazbzXYZToLMS.MultiplyXYZ(xp, yp, z).MultiplyScalar(1.0 / 10000)
+	for i := range lms {
+		lms[i] = pqEncodeWith(lms[i], jzazbzP)
+	}
+
+	iz, az, bz := JzazbzLMSToIab.Multiply(lms).Values()
+	jz := (1+jzazbzD)*iz/(1+jzazbzD*iz) - jzazbzD0
+	return Jzazbz{jz, az, bz}
+}
+
+// JzazbzFromARGB converts a ARGB color to Jzazbz.
+func JzazbzFromARGB(c ARGB) Jzazbz {
+	return JzazbzFromXYZ(c.ToXYZ())
+}
+
+// Values returns Jz, az, bz values of Jzazbz color
+func (c Jzazbz) Values() (float64, float64, float64) {
+	return c.Jz, c.Az, c.Bz
+}
+
+// Distance returns the euclidean distance between c and other.
+func (c Jzazbz) Distance(other Jzazbz) float64 {
+	dJ := c.Jz - other.Jz
+	dA := c.Az - other.Az
+	dB := c.Bz - other.Bz
+	return math.Sqrt(dJ*dJ + dA*dA + dB*dB)
+}
+
+// ToJzCzhz converts c to its polar form.
+func (c Jzazbz) ToJzCzhz() JzCzhz {
+	cz := math.Hypot(c.Az, c.Bz)
+	hz := num.NormalizeDegree(num.Degree(math.Atan2(c.Bz, c.Az)))
+	return JzCzhz{c.Jz, cz, hz}
+}
+
+// ToXYZ returns XYZ color version for c.
+func (c Jzazbz) ToXYZ() XYZ {
+	jz := c.Jz + jzazbzD0
+	iz := jz / (1 + jzazbzD - jzazbzD*jz)
+
+	lms := JzazbzIabToLMS.MultiplyXYZ(iz, c.Az, c.Bz)
+	for i := range lms {
+		lms[i] = pqDecodeWith(lms[i], jzazbzP)
+	}
+
+	xp, yp, z := JzazbzLMSToXYZ.Multiply(lms).MultiplyScalar(10000).Values()
+	x := (xp + (jzazbzB-1)*z) / jzazbzB
+	y := (yp + (jzazbzG-1)*x) / jzazbzG
+
+	scale := 100 / SDRWhiteLuminance
+	return XYZ{x * scale, y * scale, z * scale}
+}
+
+func (c Jzazbz) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c Jzazbz) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c Jzazbz) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c Jzazbz) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c Jzazbz) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
+
+// JzCzhz is the polar form of Jzazbz. Hz is hue angle in degrees.
+type JzCzhz struct {
+	Jz, Cz, Hz float64
+}
+
+var _ digitalColor = (*JzCzhz)(nil)
+
+// NewJzCzhz creates a JzCzhz color.
+func NewJzCzhz(jz, cz, hz float64) JzCzhz {
+	return JzCzhz{jz, cz, hz}
+}
+
+// JzCzhzFromARGB converts a ARGB color to JzCzhz.
+func JzCzhzFromARGB(c ARGB) JzCzhz {
+	return JzazbzFromARGB(c).ToJzCzhz()
+}
+
+// Values returns Jz, Cz, hz values of JzCzhz color
+func (c JzCzhz) Values() (float64, float64, float64) {
+	return c.Jz, c.Cz, c.Hz
+}
+
+// DeltaE returns the ΔEz color difference between c and other.
+func (c JzCzhz) DeltaE(other JzCzhz) float64 {
+	dJ := c.Jz - other.Jz
+	dC := c.Cz - other.Cz
+	dh := num.Radian(c.Hz - other.Hz)
+	dH := 2 * math.Sqrt(c.Cz*other.Cz) * math.Sin(dh/2)
+	return math.Sqrt(dJ*dJ + dC*dC + dH*dH)
+}
+
+// ToJzazbz converts c to its rectangular form.
+func (c JzCzhz) ToJzazbz() Jzazbz {
+	hRad := num.Radian(c.Hz)
+	return Jzazbz{c.Jz, c.Cz * math.Cos(hRad), c.Cz * math.Sin(hRad)}
+}
+
+func (c JzCzhz) ToXYZ() XYZ {
+	return c.ToJzazbz().ToXYZ()
+}
+
+func (c JzCzhz) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c JzCzhz) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c JzCzhz) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c JzCzhz) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c JzCzhz) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
@@ -0,0 +1,42 @@
+package color
+
+import (
+	"math"
+	"testing"
+)
+
+func TestJzazbz_White(t *testing.T) {
+	jz := JzazbzFromARGB(0xFFFFFFFF)
+	if !almostEqual(jz.Jz, 0.2220) || math.Abs(jz.Az) > 0.001 || math.Abs(jz.Bz) > 0.001 {
+		t.Errorf("JzazbzFromARGB(white) = %v, want {0.2220 0 0}", jz)
+	}
+}
+
+func TestJzazbz_RoundTrip(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			if got := JzazbzFromARGB(tt.ARGB).ToARGB(); got != tt.ARGB {
+				t.Errorf("Jzazbz(%s) Round Trip = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+			if got := JzCzhzFromARGB(tt.ARGB).ToARGB(); got != tt.ARGB {
+				t.Errorf("JzCzhz(%s) Round Trip = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+		})
+	}
+}
+
+func TestJzCzhz_DeltaE(t *testing.T) {
+	red := JzazbzFromARGB(0xFFFF0000)
+	blue := JzazbzFromARGB(0xFF0000FF)
+
+	// For colors with equal hue ΔEz is the same as euclidean distance
+	gray1 := JzazbzFromARGB(0xFF404040)
+	gray2 := JzazbzFromARGB(0xFF808080)
+	if got, want := gray1.ToJzCzhz().DeltaE(gray2.ToJzCzhz()), gray1.Distance(gray2); !almostEqual(got, want) {
+		t.Errorf("DeltaE(gray) = %f, want %f", got, want)
+	}
+
+	if got := red.ToJzCzhz().DeltaE(blue.ToJzCzhz()); got <= 0 {
+		t.Errorf("DeltaE(red, blue) = %f, want > 0", got)
+	}
+}
@@ -2,8 +2,12 @@ package color
 
 import "math"
 
+// Lab is a color in CIELAB color space with D65 white point. Use
+// Lab.ToXYZIn and LabFromXYZIn for Lab relative to other white points.
 type Lab struct {
-	L, A, B float64
+	L float64 `json:"l"`
+	A float64 `json:"a"`
+	B float64 `json:"b"`
 }
 
 var _ digitalColor = (*Lab)(nil)
@@ -39,6 +43,16 @@ func (c Lab) RGBA() (uint32, uint32, uint32, uint32) {
 
 // ToXYZ return XYZColor from LabColor
 func (c Lab) ToXYZ() XYZ {
+	return c.ToXYZIn(IlluminantD65)
+}
+
+// ToXYZIn converts c, which is relative to white, to XYZ relative to the same
+// white. For example, D50 Lab of ICC profiles is converted with
+// IlluminantD50. Use XYZ.Adapt to get D65 referred XYZ used by the rest of
+// this package.
+//
+//	xyz := lab.ToXYZIn(IlluminantD50).Adapt(IlluminantD50, IlluminantD65, Bradford)
+func (c Lab) ToXYZIn(white XYZ) XYZ {
 	l, a, b := c.Values()
 
 	fy := (l + 16.0) / 116.0
@@ -48,14 +62,19 @@ func (c Lab) ToXYZ() XYZ {
 	// Normalizied x,y,z value from LabInvFunc (Lab inverse function)
 	nx, ny, nz := LabInvFunc(fx), LabInvFunc(fy), LabInvFunc(fz)
 
-	// White WhitePointD65
-	wx, wy, wz := WhitePointD65.Values()
+	wx, wy, wz := white.Values()
 
-	// Denormalized value from WhitePointD65
+	// Denormalized value from the white point
 	x, y, z := nx*wx, ny*wy, nz*wz
 	return XYZ{x, y, z}
 }
 
+// LabFromXYZIn converts xyz to Lab relative to white. It is the inverse of
+// Lab.ToXYZIn and the same as xyz.ToLabIn(white).
+func LabFromXYZIn(xyz XYZ, white XYZ) Lab {
+	return xyz.ToLabIn(white)
+}
+
 func (c Lab) ToLab() Lab {
 	return c
 }
@@ -78,9 +97,10 @@ func (c Lab) LuminanceY() float64 {
 	return YFromLstar(c.L)
 }
 
-// DistanceSquared returns square of distance between two color
+// DistanceSquared returns square of euclidean distance between two color
 func (a Lab) DistanceSquared(b Lab) float64 {
-	return a.L*b.L + a.A*b.A + a.B*b.B
+	dL, dA, dB := a.L-b.L, a.A-b.A, a.B-b.B
+	return dL*dL + dA*dA + dB*dB
 }
 
 // YFromLstar converts an L* (perceptual luminance) value from the CIELAB color
@@ -89,3 +89,38 @@ func TestLstarFromY_GivenValues(t *testing.T) {
 		})
 	}
 }
+
+func TestLab_DistanceSquared(t *testing.T) {
+	a := NewLab(50, 10, -20)
+	b := NewLab(53, 14, -20)
+	if got := a.DistanceSquared(b); !almostEqual(got, 25) {
+		t.Errorf("DistanceSquared() = %v; want 25", got)
+	}
+	if got := a.DistanceSquared(a); got != 0 {
+		t.Errorf("DistanceSquared() to itself = %v; want 0", got)
+	}
+}
+
+func TestLab_ToXYZIn(t *testing.T) {
+	// sRGB red as D50 Lab, the way ICC profiles store it
+	d50Red := Lab{54.2917, 80.8125, 69.8851}
+	xyz := d50Red.ToXYZIn(IlluminantD50).Adapt(IlluminantD50, IlluminantD65, Bradford)
+	if got := xyz.ToARGB(); got != 0xFFFF0000 {
+		t.Errorf("D50 red = %s, want #FF0000", got.HexRGB())
+	}
+
+	// Reading it as D65 Lab shifts the color
+	if got := d50Red.ToARGB(); got == 0xFFFF0000 {
+		t.Errorf("D50 red read as D65 Lab = %s, want shifted", got.HexRGB())
+	}
+
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			d50 := tt.XYZ.Adapt(IlluminantD65, IlluminantD50, Bradford)
+			got := LabFromXYZIn(d50, IlluminantD50).ToXYZIn(IlluminantD50)
+			if !almostEqual(got.X, d50.X) || !almostEqual(got.Y, d50.Y) || !almostEqual(got.Z, d50.Z) {
+				t.Errorf("Lab round trip = %v, want %v", got, d50)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package color
+
+import "github.com/Nadim147c/material/num"
+
+// LinRGB is a color in linear sRGB color space. R, G, B are in linear light
+// and in range [0, 1] for colors inside the sRGB gamut.
+//
+// Compositing, averaging and other physical mixing of colors should be done in
+// LinRGB rather than in gamma encoded ARGB.
+type LinRGB struct {
+	R, G, B float64
+}
+
+var _ digitalColor = (*LinRGB)(nil)
+
+// NewLinRGB creates a LinRGB color from linear components.
+func NewLinRGB(r, g, b float64) LinRGB {
+	return LinRGB{r, g, b}
+}
+
+// LinRGBFromARGB converts a ARGB color to linear sRGB.
+func LinRGBFromARGB(c ARGB) LinRGB {
+	r, g, b := Linearized3(c.Red(), c.Green(), c.Blue())
+	return LinRGB{r / 100, g / 100, b / 100}
+}
+
+// LinRGBFromXYZ converts a XYZ color to linear sRGB. Values are not clamped.
+func LinRGBFromXYZ(xyz XYZ) LinRGB {
+	r, g, b := XYZ_TO_SRGB.MultiplyXYZ(xyz.Values()).MultiplyScalar(0.01).Values()
+	return LinRGB{r, g, b}
+}
+
+// Values returns r, g, b values of LinRGB color
+func (c LinRGB) Values() (float64, float64, float64) {
+	return c.R, c.G, c.B
+}
+
+// Add returns the component wise sum of c and other.
+func (c LinRGB) Add(other LinRGB) LinRGB {
+	return LinRGB{c.R + other.R, c.G + other.G, c.B + other.B}
+}
+
+// Scale returns c with every component multiplied by s.
+func (c LinRGB) Scale(s float64) LinRGB {
+	return LinRGB{c.R * s, c.G * s, c.B * s}
+}
+
+// Mix linearly interpolates from c towards other. amount must be between 0.0
+// and 1.0.
+func (c LinRGB) Mix(other LinRGB, amount float64) LinRGB {
+	return LinRGB{
+		num.Lerp(c.R, other.R, amount),
+		num.Lerp(c.G, other.G, amount),
+		num.Lerp(c.B, other.B, amount),
+	}
+}
+
+// InGamut reports whether every component of c is in range [0, 1].
+func (c LinRGB) InGamut() bool {
+	return 0 <= c.R && c.R <= 1 && 0 <= c.G && c.G <= 1 && 0 <= c.B && c.B <= 1
+}
+
+// ToXYZ returns XYZ color version for c.
+func (c LinRGB) ToXYZ() XYZ {
+	x, y, z := SRGB_TO_XYZ.MultiplyXYZ(c.R, c.G, c.B).MultiplyScalar(100).Values()
+	return XYZ{x, y, z}
+}
+
+// ToARGB converts c to ARGB. Out of gamut components are clamped.
+func (c LinRGB) ToARGB() ARGB {
+	return ARGBFromLinRGB(c.R*100, c.G*100, c.B*100)
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c LinRGB) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c LinRGB) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c LinRGB) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c LinRGB) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
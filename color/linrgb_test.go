@@ -0,0 +1,30 @@
+package color
+
+import "testing"
+
+func TestLinRGB_RoundTrip(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			lin := LinRGBFromARGB(tt.ARGB)
+			if got := lin.ToARGB(); got != tt.ARGB {
+				t.Errorf("LinRGB(%s) Round Trip = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+			}
+			if got := lin.ToXYZ(); !sameXYZ(got, tt.XYZ) {
+				t.Errorf("LinRGB(%s).ToXYZ() = %v, want %v", tt.ARGB.HexRGB(), got, tt.XYZ)
+			}
+		})
+	}
+}
+
+func TestLinRGB_Mix(t *testing.T) {
+	black := LinRGBFromARGB(0xFF000000)
+	white := LinRGBFromARGB(0xFFFFFFFF)
+
+	// Half way between black and white in linear light is #BCBCBC not #808080
+	if got := black.Mix(white, 0.5).ToARGB(); got != 0xFFBCBCBC {
+		t.Errorf("Mix(black, white, 0.5) = %s, want #BCBCBC", got.HexRGB())
+	}
+	if got := black.Add(white.Scale(0.5)); got != black.Mix(white, 0.5) {
+		t.Errorf("Add(Scale()) = %v, want %v", got, black.Mix(white, 0.5))
+	}
+}
@@ -0,0 +1,149 @@
+package color
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/num"
+)
+
+// Luv is a color in CIELUV color space with D65 white point.
+type Luv struct {
+	L, U, V float64
+}
+
+var _ digitalColor = (*Luv)(nil)
+
+// NewLuv creates a CIELUV color.
+func NewLuv(l, u, v float64) Luv {
+	return Luv{l, u, v}
+}
+
+// whitePointUV returns u′ and v′ chromaticity of IlluminantD65
+func whitePointUV() (float64, float64) {
+	wx, wy, wz := IlluminantD65.Values()
+	denom := wx + 15*wy + 3*wz
+	return 4 * wx / denom, 9 * wy / denom
+}
+
+// LuvFromXYZ converts a XYZ color to CIELUV.
+func LuvFromXYZ(xyz XYZ) Luv {
+	x, y, z := xyz.Values()
+	l := LstarFromY(y)
+
+	denom := x + 15*y + 3*z
+	if l == 0 || denom == 0 {
+		return Luv{l, 0, 0}
+	}
+
+	un, vn := whitePointUV()
+	u := 13 * l * (4*x/denom - un)
+	v := 13 * l * (9*y/denom - vn)
+	return Luv{l, u, v}
+}
+
+// LuvFromARGB converts a ARGB color to CIELUV.
+func LuvFromARGB(c ARGB) Luv {
+	return LuvFromXYZ(c.ToXYZ())
+}
+
+// Values returns L, u, v values of Luv color
+func (c Luv) Values() (float64, float64, float64) {
+	return c.L, c.U, c.V
+}
+
+// ToLChuv converts c to its cylindrical form.
+func (c Luv) ToLChuv() LChuv {
+	chroma := math.Hypot(c.U, c.V)
+	hue := 0.0
+	if chroma > 1e-8 {
+		hue = num.NormalizeDegree(num.Degree(math.Atan2(c.V, c.U)))
+	}
+	return LChuv{c.L, chroma, hue}
+}
+
+// ToXYZ returns XYZ color version for c.
+func (c Luv) ToXYZ() XYZ {
+	if c.L <= 0 {
+		return XYZ{0, 0, 0}
+	}
+
+	un, vn := whitePointUV()
+	up := c.U/(13*c.L) + un
+	vp := c.V/(13*c.L) + vn
+
+	y := YFromLstar(c.L)
+	x := y * 9 * up / (4 * vp)
+	z := y * (12 - 3*up - 20*vp) / (4 * vp)
+	return XYZ{x, y, z}
+}
+
+func (c Luv) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c Luv) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c Luv) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c Luv) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c Luv) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
+
+// LChuv is the cylindrical form of CIELUV. H is hue angle in degrees.
+type LChuv struct {
+	L, C, H float64
+}
+
+var _ digitalColor = (*LChuv)(nil)
+
+// NewLChuv creates a LChuv color.
+func NewLChuv(l, c, h float64) LChuv {
+	return LChuv{l, c, h}
+}
+
+// Values returns L, C, h values of LChuv color
+func (c LChuv) Values() (float64, float64, float64) {
+	return c.L, c.C, c.H
+}
+
+// ToLuv converts c to its rectangular form.
+func (c LChuv) ToLuv() Luv {
+	hRad := num.Radian(c.H)
+	return Luv{c.L, c.C * math.Cos(hRad), c.C * math.Sin(hRad)}
+}
+
+func (c LChuv) ToXYZ() XYZ {
+	return c.ToLuv().ToXYZ()
+}
+
+func (c LChuv) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c LChuv) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c LChuv) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c LChuv) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c LChuv) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
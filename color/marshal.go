@@ -0,0 +1,187 @@
+package color
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidBinary is returned when binary data has wrong length.
+var ErrInvalidBinary = errors.New("invalid binary color data")
+
+// marshalTriple returns text form of three float values separated by space.
+// Values are formatted with the shortest representation that round trips.
+func marshalTriple(a, b, c float64) []byte {
+	buf := make([]byte, 0, 64)
+	buf = strconv.AppendFloat(buf, a, 'g', -1, 64)
+	buf = append(buf, ' ')
+	buf = strconv.AppendFloat(buf, b, 'g', -1, 64)
+	buf = append(buf, ' ')
+	buf = strconv.AppendFloat(buf, c, 'g', -1, 64)
+	return buf
+}
+
+// unmarshalTriple parses text created by marshalTriple.
+func unmarshalTriple(name string, text []byte) (a, b, c float64, err error) {
+	fields := strings.Fields(string(text))
+	if len(fields) != 3 {
+		return 0, 0, 0, fmt.Errorf("invalid %s: expected 3 values, got %d", name, len(fields))
+	}
+	var values [3]float64
+	for i, field := range fields {
+		values[i], err = strconv.ParseFloat(field, 64)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+	return values[0], values[1], values[2], nil
+}
+
+// isJSONString reports whether data is a JSON string.
+func isJSONString(data []byte) bool {
+	return len(bytes.TrimSpace(data)) > 0 && bytes.TrimSpace(data)[0] == '"'
+}
+
+// MarshalText implements encoding.TextMarshaler. The format is hue, chroma
+// and tone separated by space, e.g. "27.4 113.3 53.2".
+func (h Hct) MarshalText() ([]byte, error) {
+	return marshalTriple(h.Hue, h.Chroma, h.Tone), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (h *Hct) UnmarshalText(text []byte) (err error) {
+	h.Hue, h.Chroma, h.Tone, err = unmarshalTriple("hct", text)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler. The format is
+// {"hue":27.4,"chroma":113.3,"tone":53.2}.
+func (h Hct) MarshalJSON() ([]byte, error) {
+	type plain Hct
+	return json.Marshal(plain(h))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both object and text form as a
+// JSON string are accepted.
+func (h *Hct) UnmarshalJSON(data []byte) error {
+	if isJSONString(data) {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return h.UnmarshalText([]byte(text))
+	}
+	type plain Hct
+	return json.Unmarshal(data, (*plain)(h))
+}
+
+// MarshalText implements encoding.TextMarshaler. The format is L, a and b
+// separated by space, e.g. "53.2 80.1 67.2".
+func (c Lab) MarshalText() ([]byte, error) {
+	return marshalTriple(c.L, c.A, c.B), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *Lab) UnmarshalText(text []byte) (err error) {
+	c.L, c.A, c.B, err = unmarshalTriple("lab", text)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler. The format is
+// {"l":53.2,"a":80.1,"b":67.2}.
+func (c Lab) MarshalJSON() ([]byte, error) {
+	type plain Lab
+	return json.Marshal(plain(c))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both object and text form as a
+// JSON string are accepted.
+func (c *Lab) UnmarshalJSON(data []byte) error {
+	if isJSONString(data) {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return c.UnmarshalText([]byte(text))
+	}
+	type plain Lab
+	return json.Unmarshal(data, (*plain)(c))
+}
+
+// MarshalText implements encoding.TextMarshaler. The format is x, y and z
+// separated by space, e.g. "41.2 21.3 1.9".
+func (c XYZ) MarshalText() ([]byte, error) {
+	return marshalTriple(c.X, c.Y, c.Z), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *XYZ) UnmarshalText(text []byte) (err error) {
+	c.X, c.Y, c.Z, err = unmarshalTriple("xyz", text)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler. The format is
+// {"x":41.2,"y":21.3,"z":1.9}.
+func (c XYZ) MarshalJSON() ([]byte, error) {
+	type plain XYZ
+	return json.Marshal(plain(c))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both object and text form as a
+// JSON string are accepted.
+func (c *XYZ) UnmarshalJSON(data []byte) error {
+	if isJSONString(data) {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return c.UnmarshalText([]byte(text))
+	}
+	type plain XYZ
+	return json.Unmarshal(data, (*plain)(c))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The color is encoded as
+// 4 byte big endian 0xAARRGGBB.
+func (c ARGB) MarshalBinary() ([]byte, error) {
+	return binary.BigEndian.AppendUint32(nil, uint32(c)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (c *ARGB) UnmarshalBinary(data []byte) error {
+	if len(data) != 4 {
+		return ErrInvalidBinary
+	}
+	*c = ARGB(binary.BigEndian.Uint32(data))
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. Hue, chroma and tone are
+// encoded as big endian IEEE 754 float64 values, 24 bytes in total.
+func (h Hct) MarshalBinary() ([]byte, error) {
+	return h.AppendBinary(make([]byte, 0, 24))
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+func (h Hct) AppendBinary(b []byte) ([]byte, error) {
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(h.Hue))
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(h.Chroma))
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(h.Tone))
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (h *Hct) UnmarshalBinary(data []byte) error {
+	if len(data) != 24 {
+		return ErrInvalidBinary
+	}
+	h.Hue = math.Float64frombits(binary.BigEndian.Uint64(data[0:8]))
+	h.Chroma = math.Float64frombits(binary.BigEndian.Uint64(data[8:16]))
+	h.Tone = math.Float64frombits(binary.BigEndian.Uint64(data[16:24]))
+	return nil
+}
@@ -0,0 +1,116 @@
+package color
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestHct_JSON(t *testing.T) {
+	h := Hct{Hue: 27.408, Chroma: 113.357, Tone: 53.237}
+	data, err := json.Marshal(h)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `{"hue":27.408,"chroma":113.357,"tone":53.237}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var got Hct
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got != h {
+		t.Errorf("json.Unmarshal() = %+v, want %+v", got, h)
+	}
+
+	if err := json.Unmarshal([]byte(`"1 2 3"`), &got); err != nil {
+		t.Fatalf("json.Unmarshal(text) error = %v", err)
+	}
+	if want := (Hct{1, 2, 3}); got != want {
+		t.Errorf("json.Unmarshal(text) = %+v, want %+v", got, want)
+	}
+}
+
+func TestMarshalText_RoundTrip(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			hct := tt.ARGB.ToHct()
+			text, _ := hct.MarshalText()
+			var gotHct Hct
+			if err := gotHct.UnmarshalText(text); err != nil || gotHct != hct {
+				t.Errorf("Hct text round trip = %+v, %v, want %+v", gotHct, err, hct)
+			}
+
+			lab := tt.ARGB.ToLab()
+			text, _ = lab.MarshalText()
+			var gotLab Lab
+			if err := gotLab.UnmarshalText(text); err != nil || gotLab != lab {
+				t.Errorf("Lab text round trip = %+v, %v, want %+v", gotLab, err, lab)
+			}
+
+			xyz := tt.ARGB.ToXYZ()
+			data, _ := json.Marshal(xyz)
+			var gotXYZ XYZ
+			if err := json.Unmarshal(data, &gotXYZ); err != nil || gotXYZ != xyz {
+				t.Errorf("XYZ json round trip = %+v, %v, want %+v", gotXYZ, err, xyz)
+			}
+		})
+	}
+}
+
+func TestUnmarshalText_Invalid(t *testing.T) {
+	var lab Lab
+	if err := lab.UnmarshalText([]byte("1 2")); err == nil {
+		t.Errorf("UnmarshalText(\"1 2\") error = nil, want error")
+	}
+	if err := lab.UnmarshalText([]byte("1 2 x")); err == nil {
+		t.Errorf("UnmarshalText(\"1 2 x\") error = nil, want error")
+	}
+}
+
+func TestMarshalBinary_RoundTrip(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			data, _ := tt.ARGB.MarshalBinary()
+			var argb ARGB
+			if err := argb.UnmarshalBinary(data); err != nil || argb != tt.ARGB {
+				t.Errorf("ARGB binary round trip = %s, %v, want %s", argb.HexARGB(), err, tt.ARGB.HexARGB())
+			}
+
+			hct := tt.ARGB.ToHct()
+			data, _ = hct.MarshalBinary()
+			var gotHct Hct
+			if err := gotHct.UnmarshalBinary(data); err != nil || gotHct != hct {
+				t.Errorf("Hct binary round trip = %+v, %v, want %+v", gotHct, err, hct)
+			}
+		})
+	}
+
+	var argb ARGB
+	if err := argb.UnmarshalBinary([]byte{1, 2}); !errors.Is(err, ErrInvalidBinary) {
+		t.Errorf("UnmarshalBinary(short) error = %v, want %v", err, ErrInvalidBinary)
+	}
+}
+
+func TestGob(t *testing.T) {
+	type theme struct {
+		Seed ARGB
+		Key  Hct
+	}
+	want := theme{0xFF6750A4, ARGB(0xFF6750A4).ToHct()}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	var got theme
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("gob round trip = %+v, want %+v", got, want)
+	}
+}
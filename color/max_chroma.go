@@ -0,0 +1,18 @@
+package color
+
+//go:generate go run gen_max_chroma.go
+
+// chromaUpperBound returns a chroma that no sRGB color of hue and tone
+// exceeds. It is read from maxChromaTable with a margin, since MaxChroma may
+// peak between grid points. hue must be in [0, 360] and tone in [0, 100].
+func chromaUpperBound(hue, tone float64) float64 {
+	// The last row and column are the edges of the grid, hue 360 and tone
+	// 100, so they are never the first corner of a cell.
+	h := min(int(hue)/maxChromaHueStep, len(maxChromaTable)-2)
+	t := min(int(tone)/maxChromaToneStep, len(maxChromaTable[0])-2)
+	bound := max(
+		maxChromaTable[h][t], maxChromaTable[h+1][t],
+		maxChromaTable[h][t+1], maxChromaTable[h+1][t+1],
+	)
+	return float64(bound)*1.1 + 5
+}
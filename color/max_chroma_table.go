@@ -0,0 +1,194 @@
+// Code generated by gen_max_chroma.go; DO NOT EDIT.
+
+package color
+
+const (
+	maxChromaHueStep  = 2
+	maxChromaToneStep = 2
+)
+
+// maxChromaTable holds MaxChroma rounded up to 0.1, indexed by hue and tone
+// divided by their step.
+var maxChromaTable = [181][51]float32{
+	{0.0, 26.4, 32.8, 37.0, 40.4, 43.4, 46.4, 49.2, 52.1, 55.0, 57.7, 60.5, 63.2, 65.9, 68.6, 71.2, 73.9, 76.4, 79.0, 81.5, 84.1, 86.6, 89.1, 91.5, 94.0, 96.4, 98.8, 101.2, 99.4, 91.9, 85.0, 78.6, 72.5, 66.8, 61.4, 56.3, 51.4, 46.8, 42.3, 38.0, 33.9, 30.0, 26.1, 22.6, 19.0, 15.4, 11.9, 8.7, 5.2, 1.8, 0.0},        // hue 0
+	{0.0, 26.5, 32.8, 37.2, 40.6, 43.6, 46.5, 49.5, 52.3, 55.2, 57.9, 60.7, 63.5, 66.2, 68.9, 71.5, 74.2, 76.8, 79.3, 81.8, 84.4, 86.9, 89.4, 91.9, 94.3, 96.8, 99.2, 101.6, 98.5, 91.0, 84.1, 77.6, 71.5, 65.9, 60.4, 55.3, 50.6, 45.9, 41.6, 37.3, 33.2, 29.5, 25.6, 22.1, 18.5, 15.2, 11.9, 8.4, 5.2, 1.8, 0.0},        // hue 2
+	{0.0, 26.6, 33.0, 37.4, 40.7, 43.7, 46.7, 49.6, 52.6, 55.4, 58.2, 61.0, 63.7, 66.5, 69.2, 71.9, 74.5, 77.1, 79.7, 82.3, 84.8, 87.4, 89.8, 92.4, 94.8, 97.2, 99.6, 102.1, 97.8, 90.3, 83.2, 76.7, 70.7, 65.0, 59.6, 54.5, 49.8, 45.3, 40.8, 36.7, 32.8, 29.0, 25.2, 21.6, 18.2, 14.9, 11.6, 8.4, 5.2, 1.5, 0.0},        // hue 4
+	{0.0, 26.7, 33.1, 37.5, 40.9, 43.9, 47.0, 49.9, 52.8, 55.7, 58.5, 61.3, 64.1, 66.8, 69.5, 72.2, 74.9, 77.5, 80.2, 82.7, 85.3, 87.8, 90.3, 92.8, 95.3, 97.7, 100.2, 102.6, 97.2, 89.6, 82.5, 76.1, 70.0, 64.3, 58.9, 53.9, 49.1, 44.5, 40.2, 36.1, 32.2, 28.5, 24.9, 21.4, 18.0, 14.7, 11.4, 8.1, 4.9, 1.5, 0.0},       // hue 6
+	{0.0, 26.7, 33.4, 37.7, 41.2, 44.2, 47.2, 50.2, 53.1, 56.0, 58.9, 61.7, 64.5, 67.2, 69.9, 72.6, 75.4, 78.0, 80.6, 83.2, 85.8, 88.3, 90.9, 93.4, 95.8, 98.3, 100.8, 103.3, 96.7, 89.1, 82.0, 75.4, 69.4, 63.6, 58.3, 53.2, 48.6, 44.0, 39.7, 35.7, 31.8, 28.0, 24.5, 21.0, 17.6, 14.4, 11.2, 8.1, 4.9, 1.5, 0.0},       // hue 8
+	{0.0, 26.9, 33.5, 37.9, 41.4, 44.5, 47.6, 50.5, 53.5, 56.4, 59.2, 62.1, 64.9, 67.7, 70.5, 73.1, 75.8, 78.5, 81.1, 83.7, 86.4, 88.9, 91.5, 94.0, 96.5, 99.0, 101.4, 103.9, 96.4, 88.6, 81.4, 74.8, 68.7, 63.0, 57.7, 52.7, 48.0, 43.5, 39.2, 35.2, 31.4, 27.6, 24.1, 20.8, 17.4, 14.2, 10.9, 7.9, 4.9, 1.5, 0.0},       // hue 10
+	{0.0, 27.2, 33.7, 38.2, 41.7, 44.8, 47.9, 50.9, 53.8, 56.8, 59.7, 62.6, 65.4, 68.1, 70.9, 73.7, 76.3, 79.1, 81.8, 84.4, 87.0, 89.6, 92.1, 94.7, 97.2, 99.8, 102.2, 104.7, 96.2, 88.3, 81.1, 74.4, 68.2, 62.6, 57.2, 52.2, 47.5, 43.0, 38.8, 34.8, 30.9, 27.3, 23.8, 20.4, 17.2, 14.0, 10.9, 7.7, 4.7, 1.5, 0.0},       // hue 12
+	{0.0, 27.2, 33.9, 38.5, 42.0, 45.1, 48.3, 51.3, 54.3, 57.2, 60.1, 63.0, 66.0, 68.8, 71.6, 74.2, 77.0, 79.7, 82.4, 85.1, 87.8, 90.3, 92.9, 95.5, 98.0, 100.6, 103.1, 104.9, 96.1, 88.0, 80.7, 74.0, 67.9, 62.1, 56.8, 51.8, 47.1, 42.6, 38.5, 34.5, 30.7, 27.0, 23.5, 20.3, 17.0, 13.8, 10.8, 7.7, 4.7, 1.5, 0.0},      // hue 14
+	{0.0, 27.6, 34.4, 38.7, 42.3, 45.6, 48.6, 51.8, 54.7, 57.8, 60.7, 63.6, 66.5, 69.4, 72.1, 74.9, 77.8, 80.5, 83.2, 85.9, 88.5, 91.2, 93.8, 96.4, 99.0, 101.5, 104.1, 105.1, 96.1, 87.9, 80.6, 73.8, 67.6, 61.8, 56.4, 51.4, 46.7, 42.3, 38.2, 34.2, 30.4, 26.7, 23.3, 20.0, 16.9, 13.7, 10.6, 7.6, 4.7, 1.5, 0.0},      // hue 16
+	{0.0, 27.6, 34.7, 39.2, 42.7, 45.9, 49.1, 52.3, 55.4, 58.3, 61.3, 64.2, 67.2, 70.1, 72.9, 75.8, 78.5, 81.2, 84.0, 86.7, 89.5, 92.1, 94.7, 97.3, 100.0, 102.5, 105.1, 105.4, 96.2, 88.0, 80.5, 73.6, 67.4, 61.6, 56.2, 51.2, 46.5, 42.0, 37.9, 33.9, 30.2, 26.5, 23.1, 19.8, 16.6, 13.6, 10.5, 7.6, 4.5, 1.5, 0.0},     // hue 18
+	{0.0, 28.2, 34.7, 39.5, 43.2, 46.6, 49.7, 52.8, 55.9, 58.9, 62.0, 65.0, 67.9, 70.7, 73.6, 76.6, 79.4, 82.2, 84.9, 87.7, 90.5, 93.1, 95.8, 98.4, 101.1, 103.6, 106.3, 105.8, 96.5, 88.1, 80.5, 73.6, 67.2, 61.4, 56.0, 51.0, 46.2, 41.8, 37.6, 33.7, 29.9, 26.3, 22.9, 19.6, 16.5, 13.4, 10.5, 7.4, 4.5, 1.5, 0.0},     // hue 20
+	{0.0, 28.2, 35.1, 39.9, 43.8, 47.1, 50.1, 53.4, 56.6, 59.6, 62.6, 65.8, 68.7, 71.6, 74.6, 77.4, 80.3, 83.1, 85.9, 88.7, 91.5, 94.2, 96.9, 99.6, 102.3, 104.9, 107.6, 106.5, 96.9, 88.3, 80.6, 73.6, 67.2, 61.3, 55.9, 50.8, 46.1, 41.6, 37.5, 33.5, 29.8, 26.2, 22.8, 19.5, 16.4, 13.3, 10.4, 7.4, 4.5, 1.5, 0.0},     // hue 22
+	{0.0, 28.9, 35.6, 40.3, 44.2, 47.4, 50.9, 54.1, 57.2, 60.4, 63.5, 66.6, 69.6, 72.6, 75.6, 78.5, 81.4, 84.2, 87.0, 89.9, 92.7, 95.5, 98.2, 100.9, 103.6, 106.3, 109.0, 107.3, 97.5, 88.7, 80.9, 73.7, 67.3, 61.3, 55.8, 50.7, 46.0, 41.5, 37.3, 33.4, 29.6, 26.1, 22.7, 19.4, 16.3, 13.2, 10.3, 7.3, 4.5, 1.5, 0.0},    // hue 24
+	{0.0, 28.9, 36.3, 40.8, 44.7, 48.2, 51.6, 54.9, 58.0, 61.2, 64.3, 67.5, 70.7, 73.6, 76.6, 79.6, 82.5, 85.4, 88.3, 91.2, 94.0, 96.8, 99.6, 102.4, 105.2, 107.8, 110.5, 108.3, 98.2, 89.2, 81.2, 74.0, 67.4, 61.4, 55.8, 50.7, 45.9, 41.5, 37.3, 33.3, 29.6, 26.0, 22.6, 19.4, 16.2, 13.2, 10.2, 7.3, 4.4, 1.5, 0.0},    // hue 26
+	{0.0, 28.5, 35.8, 40.1, 43.9, 47.2, 51.0, 53.8, 57.2, 60.1, 63.2, 66.0, 69.4, 72.2, 75.4, 78.3, 81.1, 84.0, 86.8, 89.8, 92.7, 95.5, 98.2, 100.9, 103.7, 106.4, 109.1, 109.4, 99.0, 89.9, 81.7, 74.3, 67.6, 61.6, 56.0, 50.8, 46.0, 41.5, 37.2, 33.3, 29.5, 25.9, 22.5, 19.3, 16.2, 13.1, 10.2, 7.3, 4.4, 1.4, 0.0},    // hue 28
+	{0.0, 26.6, 32.7, 37.4, 40.9, 43.7, 47.0, 49.9, 52.5, 55.8, 58.5, 61.2, 64.4, 67.0, 69.8, 72.3, 74.9, 78.0, 80.6, 83.2, 85.8, 88.2, 90.9, 93.4, 96.2, 98.7, 101.0, 103.6, 100.1, 90.7, 82.4, 74.8, 68.0, 61.8, 56.1, 50.9, 46.1, 41.5, 37.3, 33.3, 29.5, 25.9, 22.5, 19.3, 16.1, 13.1, 10.2, 7.3, 4.4, 1.4, 0.0},      // hue 30
+	{0.0, 24.8, 30.7, 34.8, 37.8, 40.9, 44.0, 46.6, 49.5, 52.0, 54.2, 56.9, 59.7, 62.4, 65.1, 67.6, 70.0, 72.5, 75.2, 77.6, 79.8, 82.4, 85.0, 86.9, 89.4, 91.9, 94.2, 96.7, 98.7, 91.6, 83.1, 75.4, 68.5, 62.2, 56.4, 51.1, 46.2, 41.6, 37.4, 33.3, 29.5, 26.0, 22.5, 19.3, 16.1, 13.1, 10.2, 7.3, 4.4, 1.4, 0.0},         // hue 32
+	{0.0, 22.9, 27.9, 33.1, 35.6, 38.3, 41.0, 43.6, 46.1, 48.7, 51.6, 53.4, 56.2, 58.3, 61.1, 63.1, 65.7, 68.1, 70.7, 72.6, 74.8, 77.2, 79.4, 81.6, 84.2, 86.0, 88.1, 90.2, 92.6, 92.8, 84.0, 76.2, 69.1, 62.6, 56.8, 51.4, 46.4, 41.8, 37.5, 33.4, 29.6, 26.0, 22.6, 19.3, 16.1, 13.1, 10.2, 7.3, 4.4, 1.4, 0.0},         // hue 34
+	{0.0, 21.2, 26.8, 29.9, 33.4, 36.3, 39.0, 41.1, 43.8, 45.7, 48.7, 50.6, 52.8, 55.1, 57.4, 59.5, 61.5, 64.2, 66.2, 68.6, 70.6, 72.5, 74.7, 76.6, 78.8, 81.0, 83.2, 85.0, 87.0, 89.1, 85.0, 77.0, 69.8, 63.2, 57.3, 51.7, 46.7, 42.1, 37.7, 33.6, 29.7, 26.1, 22.7, 19.4, 16.2, 13.2, 10.2, 7.3, 4.4, 1.4, 0.0},         // hue 36
+	{0.0, 20.4, 26.0, 28.4, 32.0, 33.7, 36.6, 38.6, 41.4, 43.3, 45.8, 47.7, 49.5, 52.4, 54.6, 56.3, 58.5, 60.6, 62.7, 64.7, 67.1, 69.1, 71.0, 72.4, 74.7, 76.5, 78.7, 80.9, 82.2, 84.3, 86.3, 77.9, 70.5, 63.8, 57.8, 52.2, 47.1, 42.3, 38.0, 33.8, 29.9, 26.3, 22.7, 19.4, 16.3, 13.2, 10.2, 7.3, 4.5, 1.4, 0.0},         // hue 38
+	{0.0, 19.4, 24.3, 27.0, 30.0, 32.4, 34.6, 37.4, 38.9, 41.5, 43.5, 45.4, 47.3, 49.6, 51.4, 53.6, 55.8, 57.5, 59.6, 61.2, 63.2, 65.2, 67.6, 69.1, 71.0, 73.3, 74.6, 76.4, 78.2, 80.3, 82.1, 79.1, 71.6, 64.7, 58.5, 52.8, 47.6, 42.7, 38.2, 34.1, 30.1, 26.4, 22.9, 19.6, 16.3, 13.3, 10.3, 7.4, 4.5, 1.4, 0.0},         // hue 40
+	{0.0, 18.0, 23.3, 26.2, 28.7, 31.1, 32.7, 34.9, 37.1, 39.2, 41.2, 43.1, 45.0, 47.4, 49.2, 51.5, 52.7, 54.9, 57.1, 58.2, 60.3, 62.3, 63.8, 65.8, 67.7, 69.6, 71.0, 72.9, 74.7, 76.4, 78.2, 79.9, 72.6, 65.5, 59.3, 53.4, 48.1, 43.2, 38.7, 34.4, 30.4, 26.6, 23.1, 19.7, 16.5, 13.4, 10.4, 7.4, 4.5, 1.4, 0.0},         // hue 42
+	{0.0, 18.0, 21.8, 25.6, 27.4, 29.8, 31.4, 33.7, 35.9, 37.4, 39.4, 41.4, 43.4, 45.3, 47.1, 48.9, 50.7, 52.4, 54.1, 56.3, 57.4, 59.4, 61.0, 63.0, 64.5, 66.5, 68.4, 69.8, 71.6, 73.0, 74.8, 76.2, 73.8, 66.6, 60.1, 54.1, 48.8, 43.7, 39.1, 34.8, 30.8, 26.9, 23.3, 19.9, 16.6, 13.5, 10.5, 7.5, 4.6, 1.4, 0.0},         // hue 44
+	{0.0, 16.5, 21.8, 24.2, 26.7, 28.5, 30.2, 32.5, 34.1, 36.2, 37.8, 39.8, 41.8, 43.7, 45.1, 46.9, 48.7, 50.4, 52.2, 53.8, 55.5, 57.6, 58.7, 60.3, 62.3, 63.8, 65.3, 66.8, 68.3, 70.5, 71.9, 73.3, 74.9, 67.8, 61.1, 55.0, 49.4, 44.3, 39.7, 35.2, 31.1, 27.2, 23.5, 20.2, 16.7, 13.6, 10.5, 7.6, 4.6, 1.4, 0.0},         // hue 46
+	{0.0, 16.5, 20.4, 22.9, 25.5, 27.3, 29.0, 31.4, 32.9, 35.1, 36.1, 38.2, 40.2, 41.6, 43.5, 44.9, 47.2, 48.5, 49.9, 51.9, 53.2, 54.9, 56.9, 58.1, 59.7, 61.3, 62.8, 64.3, 66.2, 67.6, 69.1, 70.5, 72.2, 69.2, 62.2, 56.0, 50.3, 45.1, 40.3, 35.7, 31.5, 27.6, 23.8, 20.4, 17.0, 13.8, 10.6, 7.6, 4.6, 1.4, 0.0},         // hue 48
+	{0.0, 15.3, 19.6, 22.2, 24.8, 26.1, 28.0, 29.6, 31.8, 33.5, 35.2, 37.1, 38.7, 40.1, 41.5, 44.0, 45.4, 46.7, 48.4, 50.2, 51.5, 52.7, 54.8, 56.4, 57.6, 59.1, 60.8, 62.3, 63.8, 65.3, 66.8, 68.2, 69.6, 70.7, 63.5, 57.1, 51.2, 45.8, 41.0, 36.3, 32.0, 28.0, 24.3, 20.6, 17.2, 14.0, 10.8, 7.7, 4.7, 1.6, 0.0},         // hue 50
+	{0.0, 15.3, 19.0, 21.7, 23.6, 25.5, 27.3, 28.6, 30.9, 32.4, 34.1, 35.6, 37.3, 39.1, 40.7, 42.1, 43.9, 45.3, 46.7, 48.1, 50.1, 51.4, 53.1, 54.3, 56.0, 57.2, 58.7, 60.3, 61.8, 63.3, 64.1, 65.6, 67.4, 68.9, 65.0, 58.3, 52.3, 46.7, 41.7, 36.9, 32.5, 28.5, 24.6, 20.9, 17.5, 14.1, 10.9, 7.7, 4.7, 1.6, 0.0},         // hue 52
+	{0.0, 15.3, 18.4, 21.1, 22.6, 24.5, 26.2, 28.0, 29.8, 31.4, 33.1, 34.7, 36.3, 37.8, 39.3, 40.8, 42.3, 44.0, 45.4, 46.8, 48.6, 49.9, 51.1, 52.9, 54.0, 55.7, 56.9, 58.4, 60.0, 60.8, 62.3, 63.8, 65.3, 66.4, 66.7, 59.7, 53.6, 47.9, 42.5, 37.7, 33.3, 29.1, 25.0, 21.3, 17.9, 14.4, 11.1, 7.9, 4.7, 1.6, 0.0},         // hue 54
+	{0.0, 14.2, 18.4, 20.1, 22.6, 23.9, 25.3, 27.0, 29.2, 30.6, 31.8, 33.8, 35.0, 36.9, 38.5, 39.5, 41.0, 42.5, 43.9, 45.6, 47.0, 48.6, 49.7, 51.0, 52.6, 53.9, 55.5, 56.6, 58.2, 59.5, 60.6, 62.1, 63.3, 64.8, 66.2, 61.3, 54.9, 48.9, 43.6, 38.6, 34.0, 29.7, 25.6, 21.7, 18.1, 14.8, 11.3, 8.1, 4.9, 1.6, 0.0},         // hue 56
+	{0.0, 14.2, 17.3, 20.1, 22.0, 23.5, 25.3, 26.6, 28.4, 29.7, 31.3, 33.0, 34.2, 36.1, 37.2, 38.8, 40.3, 41.7, 42.7, 44.1, 45.5, 47.3, 48.6, 49.9, 51.2, 52.8, 53.8, 55.4, 56.5, 57.8, 59.0, 60.5, 61.7, 63.2, 64.1, 63.1, 56.3, 50.4, 44.7, 39.5, 34.9, 30.4, 26.3, 22.3, 18.5, 15.0, 11.5, 8.3, 4.9, 1.6, 0.0},         // hue 58
+	{0.0, 13.4, 17.3, 19.5, 21.1, 22.9, 24.4, 26.2, 27.6, 29.1, 30.5, 31.8, 33.7, 34.9, 36.5, 37.5, 39.1, 40.6, 42.1, 43.5, 44.9, 46.2, 47.5, 48.9, 50.0, 51.2, 52.8, 53.8, 55.0, 56.5, 57.8, 59.0, 60.2, 61.4, 62.6, 64.0, 58.2, 51.8, 46.0, 40.6, 35.8, 31.1, 26.8, 22.8, 19.0, 15.4, 11.8, 8.3, 5.2, 1.6, 0.0},         // hue 60
+	{0.0, 13.4, 16.7, 19.1, 21.1, 22.2, 23.9, 25.4, 27.0, 28.5, 29.8, 31.3, 33.0, 34.2, 35.3, 37.0, 38.5, 39.6, 41.0, 42.5, 43.9, 45.2, 46.3, 47.7, 49.0, 50.3, 51.3, 52.9, 54.1, 55.1, 56.6, 57.6, 59.1, 60.0, 61.3, 62.7, 60.0, 53.5, 47.4, 41.8, 36.8, 32.1, 27.5, 23.6, 19.5, 15.7, 12.3, 8.6, 5.2, 1.6, 0.0},         // hue 62
+	{0.0, 13.4, 16.7, 18.6, 20.3, 22.2, 23.3, 24.9, 26.4, 27.7, 29.3, 30.6, 31.9, 33.6, 34.8, 36.3, 37.5, 39.0, 40.1, 41.5, 42.9, 44.3, 45.4, 46.8, 48.1, 49.2, 50.5, 51.5, 52.7, 54.3, 55.3, 56.4, 57.8, 58.8, 60.2, 61.5, 62.2, 55.2, 49.0, 43.3, 37.8, 33.1, 28.3, 24.1, 20.0, 16.2, 12.5, 8.9, 5.5, 1.6, 0.0},         // hue 64
+	{0.0, 12.9, 16.0, 18.2, 19.8, 21.4, 22.8, 24.3, 25.9, 27.4, 28.8, 30.1, 31.4, 32.6, 34.4, 35.4, 36.9, 38.1, 39.6, 40.6, 42.0, 43.2, 44.6, 46.0, 47.1, 48.4, 49.6, 50.8, 52.0, 53.1, 54.3, 55.6, 56.6, 58.0, 59.0, 60.3, 61.4, 57.4, 50.8, 44.8, 39.1, 34.2, 29.4, 24.9, 20.8, 16.8, 12.8, 9.1, 5.5, 1.9, 0.0},         // hue 66
+	{0.0, 12.6, 16.0, 17.9, 19.8, 21.0, 22.8, 23.9, 25.4, 26.8, 28.3, 29.7, 31.0, 32.3, 33.5, 35.1, 36.3, 37.5, 38.8, 40.2, 41.3, 42.5, 43.9, 45.2, 46.4, 47.4, 48.6, 50.1, 51.1, 52.4, 53.5, 54.5, 55.7, 57.0, 58.0, 59.3, 60.3, 59.7, 52.8, 46.4, 40.8, 35.3, 30.5, 25.7, 21.4, 17.3, 13.4, 9.4, 5.8, 1.9, 0.0},         // hue 68
+	{0.0, 12.6, 15.5, 17.6, 19.3, 21.0, 22.3, 23.7, 25.0, 26.4, 27.9, 29.2, 30.6, 31.8, 33.1, 34.3, 35.8, 37.0, 38.3, 39.5, 40.9, 41.9, 43.2, 44.5, 45.7, 46.8, 47.9, 49.2, 50.3, 51.4, 52.6, 53.9, 55.0, 56.1, 57.2, 58.4, 59.4, 60.4, 55.0, 48.4, 42.4, 36.8, 31.6, 26.6, 22.2, 17.9, 13.7, 9.7, 5.8, 1.9, 0.0},         // hue 70
+	{0.0, 12.2, 15.5, 17.6, 19.3, 20.5, 21.9, 23.4, 24.6, 26.0, 27.6, 28.9, 30.2, 31.5, 32.7, 34.0, 35.2, 36.6, 37.8, 38.9, 40.2, 41.6, 42.6, 43.9, 45.1, 46.2, 47.3, 48.5, 49.7, 50.9, 51.9, 53.1, 54.2, 55.3, 56.5, 57.6, 58.7, 59.8, 57.6, 50.6, 44.1, 38.2, 32.9, 27.8, 23.2, 18.6, 14.3, 10.0, 6.1, 1.9, 0.0},        // hue 72
+	{0.0, 12.2, 15.1, 17.3, 18.9, 20.2, 21.7, 23.1, 24.6, 25.7, 27.2, 28.5, 29.9, 31.2, 32.4, 33.7, 34.8, 36.0, 37.5, 38.6, 39.7, 41.0, 42.1, 43.4, 44.6, 45.7, 46.8, 48.0, 49.2, 50.4, 51.5, 52.5, 53.7, 54.8, 55.9, 57.0, 58.0, 59.0, 60.2, 53.1, 46.3, 40.0, 34.4, 29.1, 24.1, 19.2, 14.9, 10.7, 6.4, 1.9, 0.0},        // hue 74
+	{0.0, 12.2, 15.1, 17.1, 18.6, 20.2, 21.5, 22.8, 24.2, 25.6, 26.9, 28.3, 29.6, 30.8, 32.1, 33.4, 34.6, 35.7, 37.0, 38.2, 39.4, 40.6, 41.8, 42.9, 44.1, 45.3, 46.4, 47.6, 48.7, 49.8, 50.9, 52.0, 53.1, 54.2, 55.3, 56.4, 57.5, 58.5, 59.7, 55.8, 48.7, 42.1, 36.0, 30.4, 25.1, 20.2, 15.6, 11.0, 6.7, 1.9, 0.0},        // hue 76
+	{0.0, 11.9, 15.1, 16.9, 18.6, 19.9, 21.3, 22.8, 24.0, 25.5, 26.7, 28.0, 29.4, 30.5, 31.8, 33.0, 34.2, 35.4, 36.7, 37.9, 39.1, 40.2, 41.4, 42.5, 43.8, 44.9, 46.0, 47.2, 48.2, 49.4, 50.5, 51.7, 52.6, 53.7, 54.8, 55.9, 56.9, 58.0, 59.2, 59.1, 51.5, 44.5, 37.9, 32.1, 26.5, 21.2, 16.3, 11.4, 6.9, 2.3, 0.0},        // hue 78
+	{0.0, 11.9, 14.9, 16.9, 18.4, 19.8, 21.2, 22.6, 23.9, 25.3, 26.5, 27.8, 29.2, 30.3, 31.6, 32.8, 34.0, 35.2, 36.4, 37.6, 38.8, 40.0, 41.2, 42.3, 43.4, 44.6, 45.7, 46.8, 47.9, 49.1, 50.1, 51.2, 52.4, 53.5, 54.5, 55.6, 56.7, 57.7, 58.8, 59.8, 54.6, 47.1, 40.2, 33.7, 27.8, 22.2, 17.0, 12.1, 7.1, 2.3, 0.0},        // hue 80
+	{0.0, 11.9, 14.9, 16.8, 18.4, 19.8, 21.1, 22.4, 23.7, 25.1, 26.4, 27.7, 28.9, 30.2, 31.4, 32.7, 33.9, 35.1, 36.3, 37.5, 38.6, 39.8, 40.9, 42.1, 43.2, 44.3, 45.5, 46.6, 47.7, 48.8, 49.9, 51.0, 52.1, 53.2, 54.2, 55.3, 56.4, 57.4, 58.5, 59.5, 58.2, 50.2, 42.7, 35.8, 29.6, 23.7, 18.1, 12.8, 7.5, 2.3, 0.0},        // hue 82
+	{0.0, 11.8, 14.7, 16.7, 18.3, 19.7, 21.0, 22.3, 23.7, 25.0, 26.3, 27.6, 28.8, 30.1, 31.3, 32.5, 33.7, 34.9, 36.1, 37.3, 38.5, 39.6, 40.8, 42.0, 43.1, 44.2, 45.4, 46.4, 47.6, 48.6, 49.8, 50.8, 51.9, 53.0, 54.0, 55.1, 56.2, 57.2, 58.3, 59.3, 60.3, 53.7, 45.5, 38.1, 31.7, 25.1, 19.2, 13.6, 8.3, 2.8, 0.0},        // hue 84
+	{0.0, 11.8, 14.7, 16.7, 18.3, 19.6, 21.0, 22.3, 23.7, 25.0, 26.2, 27.5, 28.8, 30.0, 31.3, 32.5, 33.7, 34.9, 36.1, 37.3, 38.4, 39.6, 40.7, 41.9, 43.0, 44.1, 45.3, 46.4, 47.4, 48.5, 49.6, 50.7, 51.8, 52.9, 53.9, 55.0, 56.0, 57.1, 58.1, 59.2, 60.2, 57.9, 49.1, 41.2, 33.8, 26.9, 20.7, 14.3, 8.7, 2.8, 0.0},        // hue 86
+	{0.0, 11.8, 14.7, 16.7, 18.2, 19.6, 21.0, 22.3, 23.6, 24.9, 26.2, 27.5, 28.8, 30.0, 31.2, 32.5, 33.7, 34.9, 36.0, 37.2, 38.4, 39.6, 40.7, 41.8, 43.0, 44.1, 45.2, 46.3, 47.4, 48.5, 49.6, 50.7, 51.8, 52.8, 53.9, 55.0, 56.0, 57.1, 58.1, 59.1, 60.2, 61.2, 53.2, 44.4, 36.3, 29.2, 22.1, 15.5, 9.1, 2.8, 0.0},        // hue 88
+	{0.0, 11.8, 14.7, 16.7, 18.3, 19.7, 21.0, 22.3, 23.7, 25.0, 26.2, 27.5, 28.8, 30.0, 31.3, 32.5, 33.7, 34.9, 36.1, 37.3, 38.4, 39.6, 40.7, 41.9, 43.0, 44.2, 45.3, 46.4, 47.5, 48.6, 49.7, 50.7, 51.8, 52.9, 54.0, 55.0, 56.1, 57.1, 58.2, 59.2, 60.2, 61.2, 58.3, 48.6, 39.5, 31.4, 24.1, 16.7, 9.9, 3.2, 0.0},        // hue 90
+	{0.0, 11.8, 14.7, 16.7, 18.3, 19.7, 21.1, 22.4, 23.7, 25.1, 26.3, 27.6, 28.9, 30.1, 31.3, 32.6, 33.8, 35.0, 36.2, 37.3, 38.5, 39.7, 40.9, 42.0, 43.1, 44.3, 45.4, 46.5, 47.6, 48.7, 49.8, 50.9, 51.9, 53.0, 54.1, 55.1, 56.2, 57.2, 58.3, 59.3, 60.3, 61.4, 62.4, 53.5, 43.7, 34.4, 26.0, 18.3, 10.7, 3.7, 0.0},       // hue 92
+	{0.0, 11.8, 14.9, 16.9, 18.4, 19.8, 21.1, 22.5, 23.8, 25.1, 26.4, 27.7, 29.0, 30.2, 31.5, 32.7, 33.9, 35.1, 36.3, 37.5, 38.7, 39.8, 41.0, 42.2, 43.3, 44.4, 45.5, 46.7, 47.8, 48.9, 49.9, 51.1, 52.1, 53.2, 54.2, 55.3, 56.4, 57.4, 58.5, 59.5, 60.6, 61.6, 62.7, 59.7, 48.4, 38.1, 28.8, 20.3, 12.0, 3.7, 0.0},       // hue 94
+	{0.0, 11.8, 14.9, 16.9, 18.4, 19.9, 21.2, 22.6, 23.9, 25.2, 26.5, 27.8, 29.1, 30.4, 31.7, 32.9, 34.1, 35.3, 36.5, 37.6, 38.9, 40.0, 41.1, 42.4, 43.5, 44.6, 45.8, 46.9, 48.0, 49.1, 50.2, 51.3, 52.4, 53.5, 54.5, 55.6, 56.7, 57.7, 58.8, 59.9, 60.9, 62.0, 62.9, 64.0, 54.6, 42.8, 32.3, 22.7, 13.2, 4.1, 0.0},       // hue 96
+	{0.0, 12.1, 15.1, 17.1, 18.6, 19.9, 21.4, 22.7, 24.0, 25.4, 26.7, 28.0, 29.3, 30.5, 31.8, 33.1, 34.3, 35.5, 36.8, 37.9, 39.1, 40.3, 41.4, 42.7, 43.8, 44.9, 46.0, 47.1, 48.4, 49.5, 50.6, 51.7, 52.7, 53.8, 54.9, 56.0, 57.1, 58.1, 59.1, 60.2, 61.3, 62.3, 63.4, 64.3, 62.8, 49.0, 36.9, 25.5, 14.9, 4.6, 0.0},       // hue 98
+	{0.0, 12.1, 15.1, 17.1, 18.6, 20.1, 21.4, 23.0, 24.3, 25.6, 26.8, 28.2, 29.4, 30.7, 32.0, 33.4, 34.6, 35.9, 37.0, 38.3, 39.4, 40.6, 41.7, 43.0, 44.1, 45.2, 46.5, 47.6, 48.7, 49.8, 50.9, 52.1, 53.1, 54.2, 55.3, 56.4, 57.5, 58.6, 59.7, 60.6, 61.7, 62.9, 63.9, 64.9, 66.0, 57.3, 42.5, 29.5, 17.0, 5.5, 0.0},       // hue 100
+	{0.0, 12.3, 15.4, 17.3, 18.9, 20.4, 21.7, 23.2, 24.5, 25.8, 27.2, 28.6, 29.8, 31.2, 32.4, 33.7, 34.9, 36.1, 37.4, 38.7, 39.8, 41.0, 42.2, 43.3, 44.5, 45.8, 46.9, 48.0, 49.2, 50.3, 51.4, 52.6, 53.6, 54.7, 55.8, 56.9, 58.0, 59.1, 60.2, 61.3, 62.4, 63.5, 64.4, 65.5, 66.6, 67.6, 50.9, 34.7, 20.3, 6.4, 0.0},       // hue 102
+	{0.0, 12.3, 15.4, 17.4, 19.2, 20.4, 22.0, 23.5, 24.8, 26.1, 27.6, 28.8, 30.1, 31.4, 32.7, 34.0, 35.3, 36.6, 37.9, 39.0, 40.2, 41.5, 42.6, 43.8, 44.9, 46.2, 47.3, 48.6, 49.7, 50.7, 52.0, 53.1, 54.2, 55.3, 56.4, 57.6, 58.7, 59.8, 60.9, 62.0, 63.1, 64.0, 65.1, 66.2, 67.3, 68.3, 63.7, 43.1, 24.5, 7.4, 0.0},       // hue 104
+	{0.0, 12.5, 15.7, 17.7, 19.2, 20.7, 22.3, 23.5, 25.1, 26.3, 27.9, 29.2, 30.4, 31.7, 33.0, 34.5, 35.8, 36.8, 38.1, 39.4, 40.7, 42.0, 43.0, 44.3, 45.6, 46.7, 48.0, 49.0, 50.3, 51.4, 52.7, 53.7, 54.8, 56.1, 57.2, 58.3, 59.4, 60.4, 61.6, 62.6, 63.7, 64.8, 65.8, 66.9, 68.1, 69.1, 70.2, 56.4, 31.4, 9.6, 0.0},       // hue 106
+	{0.0, 12.9, 15.7, 18.1, 19.5, 21.1, 22.7, 23.9, 25.4, 26.7, 28.2, 29.5, 31.0, 32.3, 33.6, 34.9, 36.1, 37.4, 38.7, 39.9, 41.2, 42.5, 43.8, 44.9, 46.1, 47.4, 48.4, 49.7, 51.1, 52.1, 53.4, 54.4, 55.5, 56.8, 57.9, 58.9, 60.2, 61.3, 62.4, 63.4, 64.5, 65.8, 66.8, 68.0, 69.0, 70.1, 71.2, 72.2, 45.0, 13.1, 0.0},      // hue 108
+	{0.0, 12.9, 16.2, 18.1, 19.9, 21.5, 23.0, 24.2, 25.8, 27.3, 28.6, 30.1, 31.4, 32.6, 34.1, 35.4, 36.7, 38.0, 39.2, 40.5, 41.8, 43.1, 44.4, 45.6, 46.9, 48.0, 49.2, 50.5, 51.7, 52.9, 54.1, 55.2, 56.5, 57.5, 58.8, 59.9, 60.9, 62.2, 63.3, 64.4, 65.6, 66.7, 67.8, 68.9, 69.9, 71.0, 72.3, 73.3, 74.4, 21.3, 0.0},      // hue 110
+	{0.0, 12.9, 16.2, 18.4, 20.3, 21.9, 23.4, 24.6, 26.2, 27.7, 28.9, 30.5, 31.9, 33.3, 34.5, 36.1, 37.3, 38.6, 39.9, 41.1, 42.4, 43.7, 44.9, 46.2, 47.5, 48.9, 50.1, 51.4, 52.6, 53.7, 55.0, 56.1, 57.3, 58.5, 59.6, 60.8, 61.9, 63.2, 64.3, 65.6, 66.6, 67.7, 69.0, 70.0, 71.1, 72.2, 73.4, 74.4, 75.5, 40.5, 0.0},      // hue 112
+	{0.0, 13.5, 16.6, 18.9, 20.3, 22.3, 23.8, 25.1, 26.5, 28.1, 29.7, 30.9, 32.4, 33.7, 35.2, 36.5, 38.0, 39.3, 40.5, 42.1, 43.3, 44.6, 45.9, 47.1, 48.4, 49.7, 51.0, 52.2, 53.5, 54.8, 55.8, 57.0, 58.4, 59.5, 60.7, 62.0, 63.2, 64.3, 65.6, 66.6, 67.9, 68.9, 70.2, 71.2, 72.3, 73.6, 74.6, 75.7, 77.0, 35.3, 0.0},      // hue 114
+	{0.0, 13.5, 17.0, 18.9, 20.8, 22.3, 24.3, 25.8, 27.0, 28.5, 30.1, 31.6, 33.1, 34.4, 36.0, 37.2, 38.6, 40.0, 41.5, 42.8, 44.0, 45.3, 46.8, 48.1, 49.3, 50.6, 51.8, 53.1, 54.4, 55.7, 57.0, 58.3, 59.5, 60.8, 61.8, 63.1, 64.3, 65.6, 66.6, 67.9, 69.1, 70.2, 71.5, 72.5, 73.8, 74.8, 76.1, 77.2, 72.2, 31.4, 0.0},      // hue 116
+	{0.0, 13.5, 17.2, 19.4, 21.4, 22.8, 24.7, 26.3, 27.8, 29.3, 30.9, 32.0, 33.6, 35.2, 36.7, 37.9, 39.5, 40.7, 42.2, 43.5, 45.0, 46.3, 47.8, 49.1, 50.3, 51.6, 53.1, 54.4, 55.6, 56.9, 58.2, 59.4, 60.7, 61.9, 63.2, 64.5, 65.8, 66.7, 68.0, 69.3, 70.6, 71.7, 72.8, 74.1, 75.1, 76.4, 77.7, 78.7, 62.0, 28.7, 0.0},      // hue 118
+	{0.0, 14.1, 17.6, 19.9, 21.7, 23.7, 25.2, 26.8, 28.2, 29.7, 31.3, 32.9, 34.4, 35.9, 37.5, 39.0, 40.3, 41.8, 43.3, 44.5, 46.1, 47.3, 48.9, 50.1, 51.4, 52.9, 54.1, 55.4, 56.9, 58.2, 59.4, 60.7, 61.9, 63.1, 64.4, 65.9, 67.2, 68.2, 69.4, 70.7, 72.0, 73.2, 74.5, 75.7, 77.0, 78.0, 79.3, 80.5, 55.1, 26.7, 0.0},      // hue 120
+	{0.0, 14.5, 18.2, 20.3, 22.2, 24.2, 25.8, 27.2, 29.1, 30.6, 32.1, 33.7, 35.2, 36.8, 38.3, 39.8, 41.3, 42.9, 44.1, 45.6, 47.0, 48.4, 49.9, 51.1, 52.6, 54.2, 55.4, 56.7, 58.2, 59.4, 60.6, 62.2, 63.4, 64.7, 66.2, 67.4, 68.7, 69.9, 71.2, 72.4, 73.7, 74.9, 76.1, 77.4, 78.6, 79.9, 81.1, 82.4, 50.3, 24.9, 0.0},      // hue 122
+	{0.0, 15.0, 18.7, 20.9, 22.7, 24.8, 26.1, 28.1, 29.6, 31.5, 33.0, 34.6, 36.0, 37.5, 39.1, 40.6, 42.1, 43.6, 45.2, 46.7, 48.2, 49.7, 51.0, 52.5, 54.0, 55.5, 56.7, 58.3, 59.5, 61.0, 62.2, 63.7, 65.0, 66.5, 67.7, 68.9, 70.3, 71.7, 72.9, 74.1, 75.6, 76.9, 78.1, 79.3, 80.5, 81.8, 83.0, 76.4, 46.6, 23.4, 0.0},      // hue 124
+	{0.0, 15.0, 18.7, 21.4, 23.7, 25.1, 27.1, 29.0, 30.4, 32.0, 33.8, 35.3, 37.2, 38.7, 40.3, 41.7, 43.2, 44.9, 46.6, 48.1, 49.6, 50.8, 52.5, 53.8, 55.4, 56.9, 58.4, 59.6, 61.1, 62.6, 64.0, 65.3, 66.8, 68.0, 69.5, 70.7, 72.2, 73.4, 74.9, 76.2, 77.6, 78.9, 80.1, 81.5, 82.7, 83.9, 85.4, 69.7, 43.4, 22.4, 0.0},      // hue 126
+	{0.0, 15.9, 19.4, 21.9, 24.2, 26.1, 27.8, 29.4, 31.2, 33.2, 34.7, 36.6, 38.0, 39.9, 41.4, 42.9, 44.7, 46.2, 47.7, 49.3, 50.7, 52.5, 54.0, 55.5, 57.0, 58.5, 60.0, 61.5, 63.0, 64.1, 65.7, 67.1, 68.6, 70.1, 71.4, 72.7, 74.2, 75.7, 76.9, 78.4, 79.6, 81.0, 82.5, 83.7, 85.1, 86.4, 87.8, 64.5, 41.0, 21.2, 0.0},      // hue 128
+	{0.0, 15.9, 19.8, 22.8, 25.1, 26.9, 28.4, 30.3, 32.2, 34.1, 35.9, 37.4, 39.2, 41.1, 42.5, 44.4, 45.8, 47.7, 49.1, 50.9, 52.4, 53.9, 55.7, 57.2, 58.6, 60.1, 61.6, 63.3, 64.8, 66.3, 67.7, 69.1, 70.7, 72.1, 73.6, 75.0, 76.5, 77.9, 79.4, 80.6, 82.0, 83.4, 84.9, 86.3, 87.5, 88.9, 87.2, 60.5, 39.1, 20.4, 0.0},      // hue 130
+	{0.0, 16.8, 21.0, 23.4, 25.6, 27.4, 29.7, 31.5, 33.4, 35.2, 37.1, 38.7, 40.3, 42.2, 44.0, 45.8, 47.2, 49.0, 50.8, 52.3, 54.0, 55.5, 57.3, 58.7, 60.5, 61.9, 63.7, 65.1, 66.9, 68.3, 69.7, 71.2, 72.9, 74.3, 75.8, 77.2, 78.9, 80.3, 81.7, 83.2, 84.6, 86.0, 87.4, 88.8, 90.2, 91.7, 81.3, 57.3, 37.3, 19.7, 0.0},      // hue 132
+	{0.0, 16.8, 21.6, 24.3, 26.4, 28.7, 30.5, 32.3, 34.5, 36.3, 38.1, 39.9, 41.7, 43.5, 45.3, 47.1, 48.9, 50.6, 52.4, 54.1, 55.9, 57.6, 59.1, 60.8, 62.5, 64.2, 65.7, 67.4, 69.1, 70.4, 72.2, 73.6, 75.3, 76.7, 78.3, 79.8, 81.4, 82.8, 84.5, 85.9, 87.5, 88.9, 90.3, 91.9, 93.3, 94.7, 76.4, 54.7, 35.8, 18.9, 0.0},      // hue 134
+	{0.0, 17.9, 22.1, 25.3, 27.6, 29.4, 31.6, 33.5, 35.6, 37.4, 39.5, 41.3, 43.3, 45.1, 46.9, 48.9, 50.7, 52.4, 54.2, 56.1, 57.8, 59.5, 61.2, 62.9, 64.6, 66.3, 68.0, 69.6, 71.3, 73.0, 74.6, 76.3, 77.9, 79.5, 81.2, 82.8, 84.2, 85.8, 87.4, 89.0, 90.6, 92.0, 93.5, 95.1, 96.5, 96.7, 72.6, 52.1, 34.4, 18.4, 0.0},      // hue 136
+	{0.0, 18.9, 22.9, 26.3, 28.4, 30.4, 32.8, 34.7, 36.8, 38.8, 40.9, 43.0, 45.0, 46.7, 48.7, 50.7, 52.5, 54.4, 56.1, 58.1, 60.0, 61.7, 63.6, 65.3, 67.1, 68.8, 70.4, 72.3, 73.9, 75.8, 77.4, 79.0, 80.8, 82.4, 84.0, 85.8, 87.4, 88.9, 90.7, 92.3, 93.8, 95.4, 97.1, 98.6, 100.2, 91.3, 69.4, 50.2, 33.4, 17.9, 0.0},     // hue 138
+	{0.0, 18.9, 23.6, 27.3, 29.7, 31.9, 34.1, 36.2, 38.3, 40.5, 42.5, 44.5, 46.7, 48.7, 50.6, 52.6, 54.5, 56.6, 58.5, 60.4, 62.2, 64.1, 66.0, 67.8, 69.6, 71.4, 73.3, 75.0, 76.8, 78.6, 80.4, 82.2, 83.9, 85.6, 87.3, 89.1, 90.8, 92.5, 94.2, 95.9, 97.5, 99.2, 100.9, 102.4, 104.0, 87.0, 66.4, 48.5, 32.3, 17.4, 0.0},   // hue 140
+	{0.0, 19.7, 24.8, 28.2, 30.9, 33.0, 35.3, 37.6, 39.9, 42.1, 44.3, 46.4, 48.5, 50.7, 52.8, 54.8, 56.8, 58.9, 60.8, 62.8, 64.8, 66.8, 68.7, 70.6, 72.6, 74.4, 76.3, 78.2, 80.1, 81.9, 83.8, 85.6, 87.4, 89.2, 91.0, 92.8, 94.5, 96.3, 98.0, 99.8, 101.5, 103.2, 105.0, 106.7, 105.6, 83.3, 64.1, 47.0, 31.6, 17.2, 0.0}, // hue 142
+	{0.0, 19.3, 23.3, 27.0, 29.8, 31.5, 34.0, 35.8, 38.3, 40.2, 42.2, 44.1, 46.2, 48.2, 50.2, 52.2, 54.0, 56.0, 58.0, 59.7, 61.7, 63.6, 65.3, 67.2, 68.9, 70.8, 72.5, 74.4, 76.0, 77.9, 79.5, 81.3, 82.9, 84.5, 86.3, 87.9, 89.7, 91.3, 92.9, 94.6, 96.2, 97.7, 99.5, 101.0, 101.0, 80.3, 62.0, 45.8, 30.9, 16.7, 0.0},    // hue 144
+	{0.0, 17.5, 21.8, 25.8, 27.9, 29.9, 31.9, 34.0, 36.2, 37.9, 40.1, 41.9, 43.7, 45.5, 47.7, 49.4, 51.2, 53.0, 54.7, 56.4, 58.2, 59.9, 61.6, 63.3, 65.0, 66.7, 68.4, 70.1, 71.7, 73.4, 75.1, 76.7, 78.4, 80.0, 81.6, 83.2, 84.6, 86.2, 87.8, 89.4, 91.0, 92.3, 93.9, 95.5, 97.0, 77.6, 60.3, 44.6, 30.3, 16.5, 0.0},      // hue 146
+	{0.0, 17.5, 21.8, 23.8, 26.3, 28.5, 30.1, 32.5, 34.3, 36.2, 38.0, 39.9, 41.7, 43.1, 45.0, 46.8, 48.6, 50.4, 52.2, 53.6, 55.4, 57.1, 58.5, 60.3, 62.0, 63.4, 65.1, 66.9, 68.2, 69.9, 71.3, 73.0, 74.4, 76.0, 77.4, 79.1, 80.5, 81.8, 83.5, 84.8, 86.5, 87.8, 89.1, 90.8, 92.0, 75.2, 58.7, 43.7, 29.6, 16.1, 0.0},      // hue 148
+	{0.0, 15.7, 20.6, 23.0, 25.5, 27.2, 29.1, 31.0, 32.5, 34.4, 36.3, 38.1, 39.6, 41.5, 42.9, 44.7, 46.6, 48.0, 49.8, 51.3, 52.7, 54.5, 55.9, 57.7, 59.1, 60.6, 62.3, 63.7, 65.1, 66.6, 68.0, 69.7, 71.1, 72.5, 73.9, 75.3, 76.7, 78.1, 79.8, 81.1, 82.5, 83.9, 85.2, 86.6, 88.0, 73.3, 57.3, 42.8, 29.1, 16.0, 0.0},      // hue 150
+	{0.0, 15.7, 19.6, 22.2, 24.2, 26.1, 27.6, 29.5, 31.5, 32.9, 34.9, 36.3, 38.2, 39.7, 41.2, 43.1, 44.5, 46.0, 47.4, 49.3, 50.7, 52.2, 53.6, 55.1, 56.5, 58.0, 59.4, 60.9, 62.3, 63.8, 65.2, 66.6, 68.0, 69.5, 70.9, 72.3, 73.7, 74.8, 76.2, 77.6, 79.1, 80.1, 81.5, 82.9, 84.3, 71.6, 56.3, 41.9, 28.7, 15.8, 0.0},      // hue 152
+	{0.0, 15.7, 18.7, 21.5, 23.1, 25.1, 26.6, 28.5, 30.0, 32.0, 33.5, 35.0, 36.5, 37.9, 39.9, 41.4, 42.8, 44.3, 45.8, 47.3, 48.8, 50.2, 51.7, 53.2, 54.3, 55.8, 57.2, 58.7, 60.2, 61.3, 62.7, 64.2, 65.7, 66.8, 68.2, 69.3, 70.8, 72.2, 73.3, 74.8, 75.9, 77.3, 78.4, 79.9, 81.0, 70.0, 55.3, 41.4, 28.2, 15.5, 0.0},      // hue 154
+	{0.0, 14.3, 17.9, 20.8, 22.5, 24.0, 26.1, 27.6, 29.1, 30.6, 32.1, 33.6, 35.1, 36.6, 38.1, 39.7, 41.2, 42.7, 44.2, 45.7, 47.2, 48.3, 49.8, 51.3, 52.4, 53.9, 55.4, 56.6, 58.0, 59.2, 60.7, 61.8, 63.3, 64.4, 65.9, 67.0, 68.2, 69.6, 70.8, 71.9, 73.4, 74.5, 75.7, 76.8, 78.3, 68.7, 54.4, 40.7, 27.9, 15.4, 0.0},      // hue 156
+	{0.0, 14.3, 17.9, 20.2, 22.0, 23.5, 25.1, 26.6, 28.1, 29.6, 31.2, 32.7, 34.2, 35.8, 37.3, 38.4, 39.9, 41.5, 42.6, 44.1, 45.7, 46.8, 48.3, 49.5, 51.0, 52.1, 53.6, 54.8, 56.0, 57.5, 58.6, 59.8, 61.3, 62.4, 63.6, 64.8, 66.3, 67.4, 68.6, 69.8, 70.9, 72.1, 73.2, 74.4, 75.6, 67.6, 53.6, 40.3, 27.7, 15.3, 0.0},      // hue 158
+	{0.0, 13.3, 17.1, 19.2, 21.2, 22.5, 24.1, 25.6, 27.2, 28.7, 30.3, 31.8, 33.4, 34.5, 36.1, 37.6, 38.7, 40.3, 41.4, 43.0, 44.1, 45.3, 46.8, 48.0, 49.5, 50.7, 51.9, 53.1, 54.6, 55.8, 57.0, 58.1, 59.3, 60.5, 61.7, 62.9, 64.1, 65.3, 66.4, 67.6, 68.8, 70.0, 71.2, 72.4, 73.5, 66.6, 52.8, 39.9, 27.4, 15.2, 0.0},      // hue 160
+	{0.0, 13.3, 16.5, 18.7, 20.5, 22.1, 23.6, 25.2, 26.7, 27.9, 29.4, 31.0, 32.1, 33.7, 34.9, 36.4, 37.6, 39.1, 40.3, 41.9, 43.0, 44.2, 45.4, 47.0, 48.2, 49.4, 50.6, 51.8, 53.0, 54.1, 55.4, 56.6, 57.8, 59.0, 60.2, 61.4, 62.6, 63.5, 64.7, 65.9, 67.1, 68.0, 69.2, 70.4, 71.5, 65.8, 52.3, 39.6, 27.2, 15.1, 0.0},      // hue 162
+	{0.0, 13.3, 16.5, 18.2, 20.0, 21.6, 23.2, 24.3, 25.9, 27.5, 28.6, 30.2, 31.3, 32.9, 34.1, 35.7, 36.8, 38.0, 39.2, 40.8, 42.0, 43.2, 44.4, 45.6, 46.8, 48.0, 49.3, 50.5, 51.7, 52.9, 54.1, 55.0, 56.2, 57.5, 58.7, 59.9, 60.8, 62.0, 63.2, 64.2, 65.4, 66.3, 67.5, 68.5, 69.7, 65.0, 51.8, 39.3, 27.1, 15.0, 0.0},      // hue 164
+	{0.0, 12.4, 16.0, 18.2, 19.6, 21.1, 22.7, 23.9, 25.4, 26.6, 28.2, 29.4, 31.0, 32.1, 33.3, 34.6, 36.1, 37.3, 38.5, 39.8, 41.0, 42.2, 43.4, 44.6, 45.9, 47.1, 48.0, 49.2, 50.5, 51.7, 52.9, 53.8, 55.1, 56.3, 57.2, 58.5, 59.4, 60.6, 61.6, 62.8, 63.7, 65.0, 65.9, 66.9, 68.1, 64.4, 51.4, 39.0, 26.9, 15.0, 0.0},      // hue 166
+	{0.0, 12.4, 15.5, 17.6, 19.1, 20.7, 21.9, 23.5, 24.7, 26.2, 27.4, 29.0, 30.2, 31.4, 32.6, 33.9, 35.1, 36.3, 37.5, 38.8, 40.0, 41.2, 42.5, 43.7, 44.9, 45.9, 47.1, 48.4, 49.3, 50.5, 51.8, 52.7, 54.0, 54.9, 56.2, 57.1, 58.2, 59.3, 60.3, 61.5, 62.5, 63.5, 64.7, 65.6, 66.6, 63.9, 51.1, 38.8, 26.8, 14.9, 0.0},      // hue 168
+	{0.0, 12.4, 15.5, 17.3, 18.7, 20.3, 21.5, 23.1, 24.3, 25.9, 27.1, 28.3, 29.5, 30.7, 32.0, 33.2, 34.4, 35.7, 36.9, 38.2, 39.4, 40.6, 41.6, 42.8, 44.1, 45.0, 46.3, 47.2, 48.5, 49.4, 50.7, 51.7, 52.9, 53.9, 55.1, 56.1, 57.1, 58.1, 59.3, 60.3, 61.3, 62.2, 63.2, 64.5, 65.4, 63.5, 50.9, 38.7, 26.8, 14.9, 0.0},      // hue 170
+	{0.0, 11.8, 15.0, 16.9, 18.7, 19.9, 21.1, 22.7, 23.9, 25.2, 26.4, 27.9, 29.2, 30.4, 31.7, 32.6, 33.8, 35.2, 36.3, 37.6, 38.5, 39.8, 41.0, 42.0, 43.2, 44.2, 45.5, 46.5, 47.7, 48.7, 49.7, 50.9, 51.9, 52.9, 54.1, 55.1, 56.1, 57.1, 58.1, 59.1, 60.3, 61.3, 62.3, 63.3, 64.3, 63.2, 50.7, 38.6, 26.7, 14.9, 0.0},      // hue 172
+	{0.0, 11.8, 14.8, 16.9, 18.3, 19.7, 20.8, 22.4, 23.6, 24.8, 26.1, 27.3, 28.6, 29.8, 31.0, 32.3, 33.3, 34.5, 35.8, 37.0, 38.0, 39.2, 40.2, 41.5, 42.5, 43.7, 44.7, 45.7, 47.0, 48.0, 49.0, 50.0, 51.2, 52.2, 53.2, 54.2, 55.2, 56.2, 57.2, 58.2, 59.2, 60.3, 61.3, 62.3, 63.3, 63.0, 50.6, 38.6, 26.7, 14.9, 0.0},      // hue 174
+	{0.0, 11.8, 14.6, 16.5, 18.0, 19.2, 20.8, 22.0, 23.3, 24.5, 25.8, 27.0, 28.3, 29.5, 30.5, 31.7, 33.0, 34.0, 35.2, 36.2, 37.5, 38.5, 39.7, 40.7, 42.0, 43.0, 44.0, 45.0, 46.3, 47.3, 48.3, 49.3, 50.3, 51.3, 52.3, 53.4, 54.4, 55.4, 56.4, 57.4, 58.4, 59.4, 60.3, 61.3, 62.3, 62.9, 50.5, 38.6, 26.8, 15.0, 0.0},      // hue 176
+	{0.0, 11.8, 14.3, 16.2, 17.7, 19.2, 20.5, 21.7, 23.0, 24.2, 25.5, 26.7, 27.7, 28.9, 30.2, 31.2, 32.5, 33.7, 34.8, 36.0, 37.0, 38.0, 39.3, 40.2, 41.3, 42.3, 43.6, 44.6, 45.6, 46.6, 47.7, 48.7, 49.7, 50.7, 51.8, 52.8, 53.8, 54.6, 55.6, 56.7, 57.6, 58.7, 59.5, 60.5, 61.5, 62.4, 50.6, 38.6, 26.8, 15.0, 0.0},      // hue 178
+	{0.0, 11.5, 14.3, 16.2, 17.7, 18.9, 20.2, 21.4, 22.7, 24.0, 25.2, 26.2, 27.5, 28.7, 29.8, 31.0, 32.0, 33.3, 34.3, 35.5, 36.6, 37.6, 38.6, 39.9, 40.9, 41.9, 43.0, 44.0, 45.1, 46.1, 47.1, 48.1, 49.1, 50.2, 51.2, 52.0, 53.1, 54.1, 55.1, 56.0, 57.0, 58.0, 58.9, 59.9, 60.7, 61.7, 50.6, 38.7, 26.9, 15.1, 0.0},      // hue 180
+	{0.0, 11.3, 14.0, 15.9, 17.4, 18.7, 19.9, 21.2, 22.5, 23.7, 24.7, 26.0, 27.3, 28.3, 29.5, 30.6, 31.8, 32.9, 33.9, 35.1, 36.2, 37.2, 38.3, 39.5, 40.5, 41.6, 42.6, 43.6, 44.7, 45.5, 46.6, 47.6, 48.6, 49.6, 50.5, 51.6, 52.6, 53.5, 54.5, 55.5, 56.4, 57.4, 58.2, 59.3, 60.1, 61.0, 50.7, 38.8, 27.0, 15.1, 0.0},      // hue 182
+	{0.0, 11.3, 14.0, 15.9, 17.2, 18.4, 19.7, 21.0, 22.2, 23.5, 24.5, 25.8, 27.0, 28.1, 29.2, 30.4, 31.4, 32.7, 33.7, 34.8, 35.8, 36.9, 37.9, 39.0, 40.0, 41.1, 42.1, 43.1, 44.2, 45.2, 46.2, 47.1, 48.2, 49.2, 50.1, 51.1, 52.0, 53.0, 54.0, 54.9, 55.8, 56.8, 57.7, 58.7, 59.6, 60.4, 51.0, 39.0, 27.2, 15.2, 0.0},      // hue 184
+	{0.0, 11.1, 13.8, 15.7, 17.2, 18.4, 19.5, 20.8, 22.0, 23.3, 24.4, 25.6, 26.7, 27.9, 29.0, 30.0, 31.3, 32.4, 33.4, 34.5, 35.5, 36.6, 37.6, 38.7, 39.7, 40.8, 41.8, 42.8, 43.9, 44.8, 45.8, 46.9, 47.8, 48.8, 49.7, 50.7, 51.6, 52.6, 53.5, 54.5, 55.4, 56.3, 57.3, 58.2, 59.1, 60.0, 51.2, 39.2, 27.4, 15.3, 0.0},      // hue 186
+	{0.0, 11.1, 13.8, 15.5, 17.0, 18.2, 19.5, 20.6, 21.9, 23.1, 24.2, 25.4, 26.5, 27.8, 28.8, 29.9, 31.0, 32.0, 33.2, 34.3, 35.4, 36.4, 37.4, 38.4, 39.5, 40.5, 41.5, 42.5, 43.5, 44.5, 45.5, 46.5, 47.4, 48.4, 49.3, 50.3, 51.3, 52.3, 53.2, 54.1, 55.0, 56.0, 56.9, 57.7, 58.6, 59.5, 51.5, 39.5, 27.6, 15.5, 0.0},      // hue 188
+	{0.0, 11.1, 13.6, 15.5, 16.8, 18.1, 19.3, 20.6, 21.7, 23.0, 24.1, 25.3, 26.4, 27.5, 28.7, 29.8, 30.8, 31.9, 33.0, 34.1, 35.1, 36.1, 37.2, 38.2, 39.2, 40.2, 41.3, 42.2, 43.3, 44.2, 45.2, 46.2, 47.2, 48.1, 49.1, 50.0, 51.0, 51.9, 52.9, 53.8, 54.7, 55.6, 56.5, 57.4, 58.4, 59.3, 51.9, 39.8, 27.8, 15.6, 0.0},      // hue 190
+	{0.0, 10.9, 13.6, 15.4, 16.8, 18.1, 19.2, 20.5, 21.6, 22.8, 24.0, 25.2, 26.3, 27.4, 28.5, 29.6, 30.6, 31.7, 32.8, 33.9, 34.9, 36.0, 37.0, 38.0, 39.0, 40.1, 41.0, 42.1, 43.0, 44.0, 45.0, 46.0, 46.9, 47.9, 48.9, 49.8, 50.7, 51.6, 52.6, 53.5, 54.4, 55.4, 56.3, 57.2, 58.1, 59.0, 52.3, 40.1, 28.1, 15.8, 0.0},      // hue 192
+	{0.0, 10.9, 13.6, 15.4, 16.7, 18.0, 19.2, 20.4, 21.6, 22.7, 23.9, 25.1, 26.2, 27.3, 28.4, 29.5, 30.6, 31.6, 32.7, 33.8, 34.8, 35.8, 36.9, 37.9, 38.9, 39.9, 40.9, 41.9, 42.9, 43.9, 44.8, 45.8, 46.8, 47.7, 48.7, 49.6, 50.5, 51.4, 52.4, 53.3, 54.2, 55.2, 56.0, 56.9, 57.8, 58.7, 52.8, 40.6, 28.4, 16.0, 0.0},      // hue 194
+	{0.0, 10.9, 13.5, 15.3, 16.7, 17.9, 19.1, 20.3, 21.5, 22.7, 23.8, 25.0, 26.1, 27.2, 28.3, 29.4, 30.5, 31.6, 32.6, 33.7, 34.7, 35.8, 36.8, 37.8, 38.8, 39.8, 40.8, 41.8, 42.8, 43.7, 44.7, 45.7, 46.6, 47.6, 48.5, 49.5, 50.4, 51.3, 52.2, 53.1, 54.1, 55.0, 55.9, 56.8, 57.7, 58.5, 53.4, 40.9, 28.6, 16.1, 0.0},      // hue 196
+	{0.0, 10.9, 13.5, 15.3, 16.7, 17.9, 19.1, 20.3, 21.5, 22.6, 23.8, 24.9, 26.1, 27.2, 28.3, 29.4, 30.4, 31.5, 32.6, 33.6, 34.6, 35.7, 36.7, 37.7, 38.7, 39.7, 40.7, 41.7, 42.7, 43.7, 44.6, 45.6, 46.5, 47.5, 48.4, 49.4, 50.3, 51.2, 52.1, 53.0, 54.0, 54.9, 55.8, 56.7, 57.5, 58.4, 49.9, 39.4, 28.4, 16.4, 0.0},      // hue 198
+	{0.0, 10.9, 13.5, 15.3, 16.7, 17.9, 19.1, 20.3, 21.5, 22.6, 23.8, 24.9, 26.0, 27.1, 28.2, 29.3, 30.4, 31.5, 32.5, 33.6, 34.6, 35.6, 36.7, 37.7, 38.7, 39.7, 40.7, 41.7, 42.6, 43.6, 44.6, 45.5, 46.5, 47.4, 48.4, 49.3, 50.2, 51.2, 52.1, 53.0, 53.9, 54.8, 55.7, 56.6, 57.5, 54.3, 45.1, 35.6, 25.9, 15.3, 0.0},      // hue 200
+	{0.0, 10.9, 13.5, 15.3, 16.6, 17.9, 19.1, 20.3, 21.5, 22.6, 23.8, 24.9, 26.0, 27.1, 28.2, 29.3, 30.4, 31.5, 32.5, 33.6, 34.6, 35.6, 36.7, 37.7, 38.7, 39.7, 40.7, 41.7, 42.6, 43.6, 44.6, 45.5, 46.5, 47.4, 48.4, 49.3, 50.2, 51.2, 52.1, 53.0, 53.9, 54.8, 55.7, 56.6, 57.5, 49.7, 41.3, 32.4, 23.4, 13.9, 0.0},      // hue 202
+	{0.0, 10.9, 13.5, 15.3, 16.6, 17.9, 19.1, 20.3, 21.5, 22.6, 23.8, 24.9, 26.0, 27.2, 28.3, 29.3, 30.4, 31.5, 32.5, 33.6, 34.6, 35.7, 36.7, 37.7, 38.7, 39.7, 40.7, 41.7, 42.7, 43.6, 44.6, 45.6, 46.5, 47.5, 48.4, 49.4, 50.3, 51.2, 52.1, 53.0, 53.9, 54.9, 55.8, 56.7, 53.4, 45.9, 38.1, 29.9, 21.6, 12.9, 0.0},      // hue 204
+	{0.0, 10.9, 13.5, 15.3, 16.7, 17.9, 19.1, 20.3, 21.5, 22.7, 23.8, 25.0, 26.1, 27.2, 28.3, 29.4, 30.5, 31.5, 32.6, 33.7, 34.7, 35.7, 36.8, 37.8, 38.8, 39.8, 40.8, 41.8, 42.7, 43.7, 44.7, 45.6, 46.6, 47.6, 48.5, 49.4, 50.4, 51.3, 52.2, 53.1, 54.0, 55.0, 55.8, 56.7, 49.7, 42.8, 35.5, 28.0, 20.2, 12.3, 0.0},      // hue 206
+	{0.0, 10.9, 13.5, 15.4, 16.8, 18.0, 19.2, 20.4, 21.6, 22.7, 23.9, 25.0, 26.1, 27.3, 28.4, 29.5, 30.6, 31.7, 32.7, 33.8, 34.8, 35.8, 36.8, 37.9, 38.9, 39.9, 40.9, 41.9, 42.9, 43.8, 44.8, 45.8, 46.7, 47.7, 48.7, 49.6, 50.5, 51.4, 52.3, 53.3, 54.2, 55.1, 56.0, 53.2, 46.8, 40.0, 33.3, 26.4, 18.9, 11.3, 0.0},      // hue 208
+	{0.0, 10.9, 13.7, 15.4, 16.8, 18.1, 19.3, 20.5, 21.7, 22.9, 24.0, 25.1, 26.3, 27.4, 28.5, 29.6, 30.6, 31.7, 32.8, 33.8, 34.9, 35.9, 37.0, 38.0, 39.0, 40.0, 41.0, 42.0, 43.0, 44.0, 45.0, 45.9, 46.9, 47.9, 48.8, 49.8, 50.7, 51.6, 52.5, 53.5, 54.4, 55.3, 56.2, 50.2, 44.0, 37.7, 31.2, 24.9, 18.0, 10.8, 0.0},      // hue 210
+	{0.0, 11.1, 13.7, 15.6, 16.9, 18.1, 19.3, 20.5, 21.7, 23.0, 24.1, 25.2, 26.4, 27.5, 28.7, 29.7, 30.8, 31.8, 32.9, 34.0, 35.1, 36.1, 37.2, 38.2, 39.2, 40.2, 41.2, 42.2, 43.2, 44.2, 45.2, 46.2, 47.1, 48.0, 49.0, 49.9, 50.9, 51.9, 52.8, 53.7, 54.6, 55.5, 53.3, 47.6, 41.8, 35.9, 29.8, 23.5, 17.1, 10.3, 0.0},      // hue 212
+	{0.0, 11.1, 13.8, 15.6, 16.9, 18.2, 19.4, 20.7, 21.8, 23.0, 24.3, 25.4, 26.5, 27.6, 28.8, 29.8, 31.0, 32.1, 33.2, 34.3, 35.2, 36.3, 37.3, 38.4, 39.4, 40.4, 41.4, 42.4, 43.5, 44.4, 45.5, 46.4, 47.3, 48.3, 49.3, 50.2, 51.2, 52.2, 53.0, 54.0, 54.9, 55.8, 50.7, 45.4, 39.8, 34.1, 28.4, 22.4, 16.2, 9.8, 0.0},       // hue 214
+	{0.0, 11.1, 13.8, 15.8, 17.1, 18.4, 19.6, 20.8, 22.0, 23.1, 24.4, 25.5, 26.7, 27.8, 29.0, 30.1, 31.1, 32.2, 33.3, 34.4, 35.5, 36.5, 37.6, 38.6, 39.7, 40.7, 41.7, 42.8, 43.7, 44.6, 45.7, 46.6, 47.6, 48.7, 49.5, 50.6, 51.5, 52.5, 53.3, 54.3, 55.2, 53.6, 48.4, 43.3, 37.9, 32.5, 27.0, 21.2, 15.3, 9.2, 0.0},       // hue 216
+	{0.0, 11.4, 13.8, 15.8, 17.1, 18.4, 19.7, 21.0, 22.2, 23.3, 24.6, 25.7, 26.9, 27.9, 29.1, 30.3, 31.4, 32.5, 33.6, 34.7, 35.8, 36.8, 37.8, 38.8, 40.0, 41.0, 41.9, 43.0, 44.0, 45.0, 46.0, 47.0, 48.0, 49.0, 49.9, 50.9, 51.9, 52.9, 53.7, 54.7, 55.6, 51.4, 46.5, 41.7, 36.5, 31.2, 25.9, 20.4, 14.8, 8.7, 0.0},       // hue 218
+	{0.0, 11.4, 14.1, 16.0, 17.4, 18.6, 19.8, 21.0, 22.4, 23.5, 24.8, 25.9, 27.1, 28.3, 29.3, 30.5, 31.6, 32.7, 33.8, 34.9, 36.0, 37.2, 38.2, 39.2, 40.3, 41.3, 42.2, 43.4, 44.4, 45.4, 46.4, 47.3, 48.3, 49.3, 50.4, 51.3, 52.3, 53.3, 54.3, 55.2, 54.1, 49.4, 44.8, 40.0, 35.1, 30.1, 24.8, 19.6, 14.4, 8.7, 0.0},       // hue 220
+	{0.0, 11.4, 14.1, 16.0, 17.6, 18.9, 20.1, 21.3, 22.7, 23.8, 25.1, 26.1, 27.3, 28.6, 29.7, 30.9, 32.0, 33.1, 34.2, 35.3, 36.3, 37.4, 38.5, 39.5, 40.6, 41.6, 42.8, 43.7, 44.8, 45.9, 46.7, 47.8, 48.8, 49.8, 50.8, 51.8, 52.8, 53.8, 54.7, 55.7, 52.2, 47.7, 43.1, 38.4, 33.9, 28.9, 24.1, 18.8, 13.5, 8.2, 0.0},       // hue 222
+	{0.0, 11.7, 14.4, 16.3, 17.6, 18.9, 20.4, 21.5, 22.7, 24.0, 25.3, 26.3, 27.6, 28.8, 29.9, 31.1, 32.3, 33.3, 34.4, 35.7, 36.7, 37.7, 38.9, 39.9, 41.1, 42.0, 43.1, 44.2, 45.1, 46.2, 47.3, 48.3, 49.3, 50.4, 51.3, 52.3, 53.3, 54.3, 55.2, 54.6, 50.4, 46.1, 41.8, 37.2, 32.8, 27.9, 23.3, 18.4, 13.1, 7.9, 0.0},       // hue 224
+	{0.0, 11.7, 14.4, 16.3, 17.9, 19.2, 20.4, 21.9, 22.9, 24.3, 25.6, 26.6, 27.8, 29.1, 30.2, 31.4, 32.5, 33.8, 34.9, 35.9, 37.2, 38.2, 39.4, 40.3, 41.5, 42.4, 43.6, 44.7, 45.7, 46.8, 47.7, 48.7, 49.7, 50.7, 51.9, 52.9, 53.9, 54.8, 55.7, 52.9, 48.9, 44.7, 40.4, 36.1, 31.6, 27.3, 22.6, 17.6, 12.7, 7.7, 0.0},       // hue 226
+	{0.0, 11.7, 14.8, 16.7, 17.9, 19.5, 20.7, 22.1, 23.2, 24.6, 25.9, 27.1, 28.2, 29.3, 30.7, 31.9, 33.0, 34.1, 35.4, 36.4, 37.5, 38.6, 39.8, 40.8, 41.9, 43.1, 44.1, 45.1, 46.2, 47.2, 48.3, 49.3, 50.3, 51.3, 52.5, 53.5, 54.4, 55.4, 55.3, 51.5, 47.5, 43.5, 39.3, 35.0, 30.7, 26.3, 21.9, 17.2, 12.7, 7.3, 0.0},       // hue 228
+	{0.0, 12.2, 14.8, 16.7, 18.3, 19.5, 21.0, 22.1, 23.5, 24.9, 26.2, 27.4, 28.7, 29.9, 31.0, 32.1, 33.2, 34.6, 35.6, 36.9, 37.9, 39.1, 40.3, 41.3, 42.4, 43.6, 44.7, 45.8, 46.8, 47.9, 48.9, 50.0, 51.0, 52.0, 53.2, 54.1, 55.1, 56.2, 53.8, 50.0, 46.1, 42.3, 38.1, 34.0, 29.8, 25.7, 21.2, 16.8, 12.3, 7.3, 0.0},       // hue 230
+	{0.0, 12.2, 15.2, 17.1, 18.7, 19.9, 21.4, 22.5, 23.9, 25.2, 26.5, 27.8, 29.0, 30.2, 31.3, 32.7, 33.8, 35.1, 36.2, 37.5, 38.4, 39.7, 40.9, 42.0, 42.9, 44.1, 45.2, 46.3, 47.3, 48.6, 49.6, 50.7, 51.7, 52.9, 53.9, 54.8, 55.9, 56.1, 52.4, 48.7, 45.1, 41.2, 37.1, 33.2, 29.2, 25.0, 20.9, 16.5, 11.9, 7.3, 0.0},       // hue 232
+	{0.0, 12.2, 15.2, 17.1, 18.7, 20.3, 21.7, 22.8, 24.3, 25.6, 26.9, 28.1, 29.3, 30.8, 31.9, 33.1, 34.4, 35.4, 36.8, 37.9, 39.0, 40.2, 41.4, 42.6, 43.7, 44.8, 45.9, 47.0, 48.1, 49.1, 50.4, 51.4, 52.4, 53.6, 54.5, 55.7, 56.7, 54.8, 51.2, 47.7, 44.0, 40.2, 36.4, 32.4, 28.4, 24.4, 20.2, 16.1, 11.5, 6.9, 0.0},       // hue 234
+	{0.0, 12.5, 15.6, 17.6, 19.1, 20.7, 21.9, 23.2, 24.6, 25.9, 27.2, 28.4, 30.0, 31.1, 32.3, 33.7, 34.7, 36.1, 37.4, 38.6, 39.6, 40.8, 42.0, 43.1, 44.3, 45.4, 46.8, 47.8, 48.9, 50.0, 51.2, 52.2, 53.4, 54.3, 55.5, 56.4, 57.0, 53.6, 50.1, 46.6, 43.0, 39.3, 35.5, 31.6, 27.7, 23.8, 19.9, 15.6, 11.5, 6.9, 0.0},       // hue 236
+	{0.0, 12.7, 15.6, 18.0, 19.5, 21.1, 22.2, 23.6, 25.0, 26.3, 27.6, 29.2, 30.3, 31.8, 32.9, 34.3, 35.4, 36.7, 38.0, 39.3, 40.5, 41.7, 42.9, 44.0, 45.2, 46.2, 47.3, 48.7, 49.7, 51.0, 52.0, 53.2, 54.2, 55.4, 56.3, 57.5, 55.8, 52.4, 49.1, 45.6, 42.1, 38.5, 34.8, 31.1, 27.3, 23.5, 19.2, 15.4, 11.1, 6.5, 0.0},       // hue 238
+	{0.0, 13.2, 16.1, 18.0, 20.0, 21.1, 22.6, 24.0, 25.4, 27.1, 28.3, 29.5, 31.1, 32.2, 33.6, 34.7, 36.1, 37.3, 38.6, 39.9, 41.1, 42.3, 43.5, 44.6, 46.1, 47.1, 48.2, 49.5, 50.6, 51.8, 52.8, 54.1, 55.3, 56.2, 57.4, 57.9, 54.7, 51.4, 48.1, 44.8, 41.1, 37.6, 34.1, 30.4, 26.7, 22.9, 18.9, 15.1, 10.7, 6.5, 0.0},       // hue 240
+	{0.0, 13.2, 16.6, 18.4, 20.4, 21.5, 23.0, 24.5, 26.2, 27.5, 28.7, 30.3, 31.5, 32.9, 34.0, 35.4, 36.8, 38.1, 39.4, 40.6, 41.8, 43.0, 44.4, 45.6, 46.7, 48.1, 49.1, 50.4, 51.7, 52.7, 54.0, 55.2, 56.2, 57.3, 58.5, 56.9, 53.8, 50.5, 47.2, 43.9, 40.5, 37.0, 33.4, 29.9, 26.2, 22.4, 18.6, 14.8, 10.7, 6.3, 0.0},       // hue 242
+	{0.0, 13.8, 16.6, 18.9, 20.6, 22.0, 23.5, 25.3, 26.6, 27.9, 29.4, 30.7, 32.3, 33.7, 34.8, 36.1, 37.5, 38.8, 40.1, 41.6, 42.8, 44.0, 45.1, 46.6, 47.7, 49.0, 50.4, 51.5, 52.7, 53.9, 55.2, 56.1, 57.3, 58.5, 59.0, 55.9, 52.9, 49.7, 46.5, 43.1, 39.8, 36.4, 33.0, 29.4, 25.7, 22.1, 18.4, 14.5, 10.4, 6.1, 0.0},       // hue 244
+	{0.0, 13.8, 17.2, 19.5, 20.9, 22.6, 23.9, 25.8, 27.1, 28.8, 30.0, 31.6, 33.1, 34.1, 35.5, 36.9, 38.2, 39.9, 41.1, 42.4, 43.6, 45.1, 46.2, 47.6, 49.0, 50.0, 51.3, 52.7, 53.9, 55.2, 56.4, 57.5, 58.8, 59.9, 58.1, 55.1, 52.0, 48.8, 45.8, 42.4, 39.1, 35.8, 32.3, 29.0, 25.5, 21.8, 18.1, 14.2, 10.4, 6.1, 0.0},       // hue 246
+	{0.0, 14.4, 17.7, 20.0, 21.4, 23.0, 24.9, 26.2, 28.0, 29.2, 30.9, 32.3, 33.5, 34.9, 36.3, 38.1, 39.4, 40.7, 41.9, 43.5, 44.6, 46.1, 47.2, 48.6, 50.0, 51.4, 52.7, 54.0, 55.1, 56.5, 57.7, 58.9, 60.0, 60.1, 57.2, 54.3, 51.3, 48.2, 45.0, 41.8, 38.6, 35.2, 31.9, 28.5, 25.0, 21.3, 17.8, 13.9, 10.1, 6.1, 0.0},       // hue 248
+	{0.0, 14.4, 17.7, 20.4, 22.0, 24.0, 25.4, 26.7, 28.5, 30.1, 31.7, 32.8, 34.3, 36.2, 37.6, 38.9, 40.2, 41.7, 43.1, 44.6, 45.7, 47.2, 48.7, 50.0, 51.4, 52.4, 53.7, 55.2, 56.5, 57.8, 59.0, 60.1, 61.6, 59.3, 56.4, 53.5, 50.6, 47.6, 44.4, 41.3, 38.1, 34.9, 31.5, 28.1, 24.5, 21.1, 17.6, 13.9, 10.1, 6.1, 0.0},       // hue 250
+	{0.0, 15.0, 18.3, 21.1, 22.5, 24.5, 25.9, 27.7, 29.3, 30.6, 32.2, 33.7, 35.6, 37.0, 38.4, 39.8, 41.4, 42.6, 44.2, 45.7, 47.2, 48.3, 49.7, 51.1, 52.5, 53.8, 55.4, 56.7, 57.9, 59.4, 60.6, 61.7, 61.3, 58.6, 55.8, 52.9, 50.0, 46.9, 43.9, 40.7, 37.6, 34.4, 31.2, 27.7, 24.3, 20.9, 17.3, 13.7, 9.9, 5.8, 0.0},        // hue 252
+	{0.0, 15.6, 18.9, 21.6, 23.4, 25.0, 26.9, 28.7, 29.9, 31.5, 33.1, 35.1, 36.5, 37.9, 39.7, 40.9, 42.6, 44.2, 45.4, 46.9, 48.4, 49.8, 51.2, 52.6, 53.9, 55.5, 56.8, 58.4, 59.6, 61.1, 62.3, 63.3, 60.6, 57.9, 55.2, 52.3, 49.4, 46.4, 43.3, 40.3, 37.1, 34.0, 30.8, 27.3, 24.0, 20.7, 17.1, 13.4, 9.9, 5.8, 0.0},        // hue 254
+	{0.0, 15.6, 19.5, 22.2, 24.1, 26.1, 27.5, 29.2, 30.9, 32.5, 34.3, 36.0, 37.5, 39.2, 40.5, 42.2, 43.8, 45.4, 46.9, 48.4, 49.9, 51.3, 52.7, 54.4, 55.7, 57.1, 58.6, 60.1, 61.3, 62.8, 64.2, 62.6, 60.0, 57.3, 54.6, 51.8, 48.8, 45.9, 43.0, 39.9, 36.8, 33.6, 30.5, 27.2, 23.8, 20.3, 16.9, 13.4, 9.7, 5.8, 0.0},        // hue 256
+	{0.0, 16.2, 20.2, 22.8, 24.7, 26.6, 28.4, 30.2, 31.9, 33.9, 35.4, 37.0, 38.7, 40.5, 41.8, 43.5, 45.1, 46.6, 48.2, 50.0, 51.5, 52.9, 54.6, 55.9, 57.5, 59.1, 60.3, 61.9, 63.4, 64.9, 64.6, 62.1, 59.5, 56.8, 54.1, 51.2, 48.4, 45.5, 42.5, 39.5, 36.4, 33.2, 30.1, 26.8, 23.6, 20.1, 16.7, 13.2, 9.5, 5.8, 0.0},        // hue 258
+	{0.0, 16.9, 20.7, 23.5, 25.8, 27.6, 29.5, 31.2, 33.3, 34.9, 36.8, 38.3, 40.1, 41.8, 43.5, 45.2, 46.7, 48.3, 50.2, 51.6, 53.2, 54.8, 56.5, 57.8, 59.4, 61.0, 62.5, 64.0, 65.4, 66.5, 64.1, 61.6, 58.9, 56.3, 53.6, 50.9, 48.0, 45.1, 42.1, 39.1, 36.1, 33.0, 29.9, 26.7, 23.3, 20.0, 16.5, 13.1, 9.4, 5.6, 0.0},        // hue 260
+	{0.0, 17.6, 21.9, 24.5, 26.9, 28.8, 30.5, 32.2, 34.3, 36.2, 37.8, 39.7, 41.4, 43.2, 45.0, 46.9, 48.4, 50.3, 51.8, 53.6, 55.1, 56.7, 58.4, 60.0, 61.6, 63.1, 64.7, 66.5, 67.9, 66.0, 63.6, 61.1, 58.5, 55.9, 53.2, 50.4, 47.6, 44.8, 41.8, 38.9, 35.8, 32.7, 29.6, 26.4, 23.1, 19.8, 16.4, 13.1, 9.4, 5.6, 0.0},        // hue 262
+	{0.0, 18.2, 22.5, 25.6, 28.0, 29.8, 31.6, 33.7, 35.8, 37.8, 39.7, 41.5, 43.2, 44.9, 47.0, 48.6, 50.5, 52.0, 53.8, 55.6, 57.3, 59.0, 60.6, 62.3, 64.2, 65.7, 67.2, 69.0, 67.9, 65.6, 63.2, 60.7, 58.2, 55.5, 52.9, 50.1, 47.3, 44.5, 41.5, 38.6, 35.6, 32.5, 29.4, 26.2, 23.0, 19.7, 16.4, 12.9, 9.3, 5.6, 0.0},        // hue 264
+	{0.0, 18.9, 23.7, 26.8, 29.1, 30.9, 33.1, 35.3, 37.3, 39.2, 41.1, 43.0, 45.0, 46.7, 48.7, 50.7, 52.6, 54.4, 56.2, 58.0, 59.8, 61.7, 63.3, 64.8, 66.8, 68.6, 70.0, 69.8, 67.6, 65.2, 62.8, 60.3, 57.8, 55.2, 52.5, 49.8, 47.1, 44.2, 41.3, 38.4, 35.4, 32.3, 29.3, 26.1, 22.9, 19.6, 16.3, 12.8, 9.3, 5.6, 0.0},        // hue 266
+	{0.0, 20.2, 24.3, 27.8, 30.2, 32.5, 34.7, 36.8, 38.7, 41.1, 42.9, 45.1, 47.3, 48.9, 50.9, 52.8, 54.9, 56.9, 58.7, 60.7, 62.3, 64.3, 66.3, 67.9, 69.6, 71.4, 71.7, 69.5, 67.3, 64.9, 62.6, 60.1, 57.5, 54.9, 52.3, 49.6, 46.8, 43.9, 41.1, 38.1, 35.2, 32.1, 29.1, 26.0, 22.7, 19.5, 16.2, 12.8, 9.3, 5.5, 0.0},        // hue 268
+	{0.0, 20.8, 26.1, 29.1, 31.8, 34.1, 36.2, 38.7, 40.7, 43.0, 45.2, 47.4, 49.5, 51.5, 53.4, 55.7, 57.5, 59.6, 61.7, 63.4, 65.4, 67.3, 69.2, 71.4, 73.2, 73.5, 71.4, 69.2, 67.0, 64.7, 62.3, 59.8, 57.3, 54.7, 52.1, 49.4, 46.6, 43.8, 40.9, 38.0, 35.1, 32.0, 28.9, 25.8, 22.6, 19.4, 16.1, 12.7, 9.2, 5.5, 0.0},        // hue 270
+	{0.0, 22.1, 27.2, 30.7, 33.4, 35.7, 38.2, 40.6, 43.0, 45.3, 47.5, 49.6, 52.0, 54.0, 56.3, 58.5, 60.7, 62.8, 64.8, 66.8, 69.1, 70.9, 72.8, 75.0, 75.2, 73.3, 71.2, 69.1, 66.8, 64.5, 62.1, 59.7, 57.2, 54.6, 51.9, 49.2, 46.4, 43.6, 40.8, 37.9, 34.9, 31.9, 28.9, 25.7, 22.6, 19.3, 16.0, 12.7, 9.2, 5.5, 0.0},        // hue 272
+	{0.0, 23.3, 28.9, 32.3, 35.5, 38.1, 40.6, 43.0, 45.4, 48.0, 50.1, 52.5, 55.0, 57.3, 59.5, 61.7, 64.1, 66.2, 68.5, 70.8, 72.7, 75.0, 77.0, 77.0, 75.1, 73.1, 71.1, 68.9, 66.7, 64.4, 62.0, 59.6, 57.0, 54.4, 51.8, 49.1, 46.3, 43.6, 40.7, 37.8, 34.8, 31.8, 28.8, 25.7, 22.5, 19.3, 16.0, 12.6, 9.1, 5.5, 0.0},        // hue 274
+	{0.0, 24.8, 30.6, 34.4, 37.4, 40.1, 43.0, 45.8, 48.0, 50.7, 53.4, 55.9, 58.2, 60.7, 63.0, 65.5, 67.9, 70.3, 72.6, 75.1, 77.3, 79.7, 78.7, 76.9, 75.1, 73.1, 71.0, 68.9, 66.6, 64.3, 61.9, 59.5, 57.0, 54.4, 51.7, 49.0, 46.3, 43.5, 40.6, 37.7, 34.8, 31.8, 28.7, 25.6, 22.5, 19.2, 16.0, 12.6, 9.1, 5.5, 0.0},        // hue 276
+	{0.0, 26.3, 32.7, 36.9, 39.9, 42.9, 45.7, 48.8, 51.4, 54.4, 56.9, 59.5, 62.1, 64.7, 67.4, 69.9, 72.6, 75.1, 77.5, 79.9, 82.0, 80.4, 78.7, 77.0, 75.1, 73.1, 71.0, 68.9, 66.6, 64.3, 61.9, 59.5, 56.9, 54.4, 51.7, 49.0, 46.3, 43.5, 40.6, 37.7, 34.7, 31.8, 28.7, 25.6, 22.4, 19.2, 15.9, 12.6, 9.1, 5.5, 0.0},        // hue 278
+	{0.0, 28.6, 35.2, 39.8, 43.1, 46.2, 49.2, 52.1, 55.2, 58.2, 61.0, 64.0, 66.9, 69.6, 72.3, 75.2, 77.8, 80.6, 83.3, 83.5, 82.1, 80.5, 78.8, 77.1, 75.2, 73.2, 71.1, 68.9, 66.7, 64.4, 62.0, 59.5, 57.0, 54.4, 51.7, 49.0, 46.3, 43.5, 40.6, 37.7, 34.8, 31.8, 28.7, 25.6, 22.4, 19.2, 15.9, 12.6, 9.1, 5.5, 0.0},        // hue 280
+	{0.0, 31.1, 38.1, 42.8, 46.5, 49.9, 53.1, 56.5, 59.8, 62.8, 66.1, 69.1, 72.1, 75.1, 78.2, 81.1, 84.0, 86.3, 85.1, 83.7, 82.3, 80.7, 79.0, 77.2, 75.3, 73.3, 71.2, 69.0, 66.8, 64.5, 62.1, 59.6, 57.1, 54.4, 51.8, 49.1, 46.3, 43.5, 40.7, 37.8, 34.8, 31.8, 28.7, 25.6, 22.5, 19.3, 16.0, 12.6, 9.1, 5.5, 0.0},        // hue 282
+	{0.0, 31.8, 39.1, 44.2, 48.0, 51.4, 54.8, 58.1, 61.4, 64.6, 67.8, 71.0, 74.1, 77.2, 80.2, 83.2, 86.2, 86.6, 85.4, 84.0, 82.6, 81.0, 79.3, 77.5, 75.6, 73.5, 71.4, 69.2, 67.0, 64.6, 62.2, 59.7, 57.2, 54.6, 51.9, 49.2, 46.5, 43.6, 40.8, 37.8, 34.9, 31.9, 28.8, 25.7, 22.5, 19.3, 16.0, 12.6, 9.1, 5.5, 0.0},        // hue 284
+	{0.0, 31.5, 38.8, 43.6, 47.4, 50.8, 54.1, 57.5, 60.7, 63.9, 67.1, 70.2, 73.3, 76.3, 79.3, 82.3, 85.2, 87.0, 85.8, 84.4, 82.9, 81.3, 79.6, 77.8, 75.8, 73.8, 71.7, 69.5, 67.2, 64.9, 62.5, 59.9, 57.4, 54.8, 52.1, 49.4, 46.6, 43.8, 40.9, 37.9, 35.0, 31.9, 28.8, 25.8, 22.6, 19.4, 16.0, 12.6, 9.1, 5.5, 0.0},        // hue 286
+	{0.0, 31.0, 38.3, 43.1, 46.9, 50.2, 53.5, 56.8, 60.0, 63.2, 66.3, 69.4, 72.5, 75.5, 78.4, 81.4, 84.3, 87.2, 86.3, 84.9, 83.4, 81.7, 80.0, 78.1, 76.2, 74.1, 72.0, 69.8, 67.5, 65.1, 62.7, 60.2, 57.6, 55.0, 52.3, 49.6, 46.8, 43.9, 41.0, 38.1, 35.1, 32.1, 29.0, 25.8, 22.7, 19.4, 16.1, 12.7, 9.2, 5.5, 0.0},        // hue 288
+	{0.0, 30.7, 37.8, 42.7, 46.4, 49.7, 53.0, 56.2, 59.4, 62.6, 65.6, 68.6, 71.7, 74.7, 77.6, 80.5, 83.4, 86.3, 86.8, 85.4, 83.9, 82.2, 80.5, 78.7, 76.7, 74.6, 72.5, 70.2, 67.9, 65.5, 63.0, 60.5, 58.0, 55.3, 52.5, 49.8, 47.0, 44.2, 41.3, 38.3, 35.3, 32.3, 29.2, 26.0, 22.8, 19.5, 16.2, 12.7, 9.2, 5.5, 0.0},        // hue 290
+	{0.0, 30.5, 37.5, 42.2, 45.9, 49.2, 52.4, 55.6, 58.8, 61.9, 64.9, 68.0, 70.9, 73.9, 76.8, 79.8, 82.6, 85.4, 87.5, 86.1, 84.5, 82.9, 81.1, 79.1, 77.2, 75.1, 73.0, 70.7, 68.4, 65.9, 63.5, 60.9, 58.3, 55.6, 52.9, 50.1, 47.3, 44.5, 41.5, 38.5, 35.5, 32.5, 29.3, 26.1, 22.9, 19.6, 16.3, 12.9, 9.3, 5.5, 0.0},        // hue 292
+	{0.0, 30.2, 37.1, 41.7, 45.4, 48.7, 51.9, 55.1, 58.2, 61.3, 64.3, 67.3, 70.3, 73.2, 76.1, 79.0, 81.8, 84.6, 87.4, 86.9, 85.2, 83.6, 81.8, 79.8, 77.8, 75.7, 73.6, 71.3, 68.9, 66.4, 64.0, 61.4, 58.7, 56.1, 53.3, 50.4, 47.6, 44.7, 41.8, 38.7, 35.8, 32.6, 29.5, 26.4, 23.0, 19.8, 16.4, 12.9, 9.3, 5.6, 0.0},        // hue 294
+	{0.0, 29.8, 36.7, 41.3, 45.0, 48.2, 51.4, 54.5, 57.6, 60.7, 63.7, 66.7, 69.6, 72.5, 75.4, 78.2, 81.0, 83.8, 86.6, 87.7, 86.1, 84.4, 82.5, 80.7, 78.6, 76.5, 74.3, 71.9, 69.6, 67.1, 64.5, 61.9, 59.3, 56.5, 53.8, 51.0, 48.0, 45.1, 42.1, 39.2, 36.0, 32.9, 29.8, 26.5, 23.3, 19.9, 16.4, 13.0, 9.3, 5.7, 0.0},        // hue 296
+	{0.0, 29.5, 36.3, 40.9, 44.6, 47.8, 50.9, 54.1, 57.1, 60.1, 63.1, 66.1, 69.1, 71.9, 74.7, 77.5, 80.4, 83.1, 85.8, 88.6, 87.0, 85.2, 83.4, 81.4, 79.4, 77.3, 74.9, 72.6, 70.2, 67.7, 65.2, 62.5, 59.8, 57.1, 54.3, 51.5, 48.5, 45.6, 42.6, 39.5, 36.3, 33.2, 29.9, 26.8, 23.5, 20.1, 16.6, 13.2, 9.5, 5.7, 0.0},        // hue 298
+	{0.0, 29.3, 36.1, 40.7, 44.3, 47.4, 50.5, 53.5, 56.6, 59.6, 62.6, 65.5, 68.4, 71.3, 74.1, 76.9, 79.6, 82.4, 85.1, 87.9, 88.0, 86.3, 84.4, 82.4, 80.3, 78.1, 75.8, 73.4, 71.1, 68.4, 65.9, 63.3, 60.4, 57.7, 54.9, 52.0, 49.1, 46.1, 42.9, 39.9, 36.7, 33.5, 30.3, 27.0, 23.7, 20.2, 16.8, 13.2, 9.5, 5.8, 0.0},        // hue 300
+	{0.0, 29.1, 35.7, 40.3, 43.9, 47.0, 50.1, 53.2, 56.2, 59.2, 62.1, 65.0, 67.9, 70.7, 73.5, 76.3, 79.0, 81.8, 84.5, 87.1, 89.3, 87.4, 85.5, 83.4, 81.4, 79.1, 76.8, 74.3, 71.9, 69.4, 66.7, 64.1, 61.2, 58.4, 55.4, 52.5, 49.7, 46.5, 43.5, 40.4, 37.3, 33.9, 30.7, 27.4, 24.0, 20.4, 17.0, 13.4, 9.7, 5.8, 0.0},        // hue 302
+	{0.0, 28.8, 35.5, 40.0, 43.5, 46.5, 49.7, 52.7, 55.7, 58.7, 61.6, 64.5, 67.3, 70.2, 73.0, 75.7, 78.5, 81.1, 83.9, 86.5, 89.1, 88.8, 86.8, 84.8, 82.6, 80.2, 77.9, 75.4, 72.8, 70.3, 67.6, 64.9, 62.0, 59.3, 56.3, 53.2, 50.3, 47.1, 44.1, 40.8, 37.6, 34.3, 31.1, 27.6, 24.2, 20.9, 17.2, 13.6, 9.9, 5.8, 0.0},        // hue 304
+	{0.0, 28.4, 35.1, 39.7, 43.2, 46.2, 49.3, 52.4, 55.3, 58.3, 61.1, 64.0, 66.8, 69.7, 72.5, 75.2, 77.9, 80.5, 83.3, 85.9, 88.5, 90.2, 88.3, 86.1, 83.9, 81.4, 79.2, 76.7, 74.0, 71.4, 68.6, 65.9, 63.0, 60.0, 57.2, 54.1, 51.0, 47.8, 44.8, 41.5, 38.2, 34.9, 31.5, 28.0, 24.7, 21.1, 17.4, 13.8, 9.9, 5.8, 0.0},        // hue 306
+	{0.0, 28.2, 34.9, 39.3, 42.8, 45.9, 49.0, 51.9, 54.9, 57.8, 60.7, 63.6, 66.4, 69.2, 72.0, 74.7, 77.4, 80.1, 82.7, 85.3, 87.9, 90.5, 89.8, 87.6, 85.2, 82.8, 80.3, 77.8, 75.3, 72.6, 69.8, 67.0, 64.1, 61.1, 58.0, 55.0, 51.8, 48.5, 45.3, 42.2, 38.9, 35.5, 32.0, 28.5, 25.0, 21.4, 17.7, 14.1, 10.2, 6.0, 0.0},       // hue 308
+	{0.0, 28.1, 34.7, 39.1, 42.5, 45.6, 48.7, 51.6, 54.6, 57.4, 60.3, 63.1, 65.9, 68.8, 71.5, 74.2, 76.8, 79.6, 82.2, 84.8, 87.4, 89.9, 91.4, 89.2, 86.9, 84.5, 81.9, 79.3, 76.6, 73.9, 71.0, 68.1, 65.2, 62.2, 59.2, 56.0, 52.8, 49.6, 46.1, 42.8, 39.4, 36.0, 32.6, 29.1, 25.5, 21.9, 18.2, 14.1, 10.2, 5.5, 0.0},       // hue 310
+	{0.0, 27.9, 34.4, 38.9, 42.3, 45.3, 48.3, 51.3, 54.3, 57.1, 59.9, 62.8, 65.6, 68.3, 71.0, 73.7, 76.4, 79.0, 81.7, 84.3, 86.9, 89.4, 91.9, 91.1, 88.8, 86.2, 83.5, 80.9, 78.3, 75.5, 72.6, 69.4, 66.4, 63.4, 60.3, 57.0, 53.7, 50.5, 47.2, 43.6, 40.3, 36.9, 33.1, 29.6, 25.9, 22.2, 18.3, 14.4, 10.5, 5.5, 0.0},       // hue 312
+	{0.0, 27.7, 34.2, 38.6, 41.9, 45.0, 48.0, 51.0, 53.9, 56.8, 59.6, 62.4, 65.2, 67.9, 70.6, 73.3, 75.9, 78.6, 81.2, 83.8, 86.3, 88.9, 91.4, 93.2, 90.7, 88.2, 85.4, 82.7, 79.9, 77.2, 74.0, 71.0, 68.0, 64.7, 61.6, 58.3, 55.0, 51.4, 48.2, 44.6, 41.2, 37.5, 33.8, 30.1, 26.4, 22.8, 18.8, 14.7, 10.8, 4.8, 0.0},       // hue 314
+	{0.0, 27.5, 34.0, 38.4, 41.8, 44.8, 47.8, 50.7, 53.6, 56.5, 59.3, 62.1, 64.9, 67.5, 70.2, 72.9, 75.6, 78.2, 80.8, 83.3, 85.9, 88.4, 91.0, 93.5, 93.0, 90.2, 87.6, 84.7, 81.8, 78.9, 75.8, 72.8, 69.5, 66.1, 62.9, 59.5, 56.4, 52.8, 49.1, 45.5, 42.1, 38.4, 34.7, 30.9, 27.0, 23.1, 19.1, 15.0, 10.8, 4.2, 0.0},       // hue 316
+	{0.0, 27.4, 33.9, 38.2, 41.5, 44.5, 47.5, 50.4, 53.3, 56.2, 59.0, 61.8, 64.5, 67.3, 69.9, 72.6, 75.2, 77.8, 80.4, 82.9, 85.5, 88.0, 90.5, 93.0, 95.4, 92.9, 89.9, 87.2, 84.0, 80.9, 77.8, 74.4, 71.4, 68.0, 64.6, 61.1, 57.6, 54.2, 50.6, 46.8, 43.2, 39.1, 35.5, 31.5, 27.7, 23.7, 19.7, 15.3, 11.1, 4.2, 0.0},       // hue 318
+	{0.0, 27.3, 33.7, 37.9, 41.3, 44.3, 47.3, 50.2, 53.1, 55.9, 58.7, 61.5, 64.2, 66.9, 69.6, 72.3, 74.9, 77.5, 80.0, 82.6, 85.1, 87.6, 90.1, 92.6, 95.1, 95.4, 92.5, 89.4, 86.4, 83.2, 80.0, 76.8, 73.5, 70.0, 66.4, 62.9, 59.3, 55.5, 51.8, 48.0, 44.3, 40.2, 36.5, 32.6, 28.3, 24.4, 20.1, 16.0, 11.4, 3.5, 0.0},       // hue 320
+	{0.0, 27.1, 33.5, 37.8, 41.1, 44.1, 47.1, 50.0, 52.9, 55.6, 58.5, 61.2, 63.9, 66.6, 69.3, 71.9, 74.6, 77.1, 79.7, 82.2, 84.8, 87.3, 89.7, 92.2, 94.6, 97.1, 95.5, 92.4, 89.1, 85.8, 82.5, 79.1, 75.5, 72.1, 68.5, 64.5, 61.0, 57.3, 53.4, 49.4, 45.4, 41.7, 37.5, 33.3, 29.1, 25.2, 20.8, 16.4, 10.9, 3.5, 0.0},       // hue 322
+	{0.0, 27.0, 33.3, 37.6, 41.0, 43.9, 46.9, 49.8, 52.6, 55.4, 58.2, 61.0, 63.7, 66.3, 69.0, 71.6, 74.3, 76.8, 79.4, 81.9, 84.4, 86.9, 89.4, 91.8, 94.3, 96.7, 98.8, 95.5, 92.1, 88.9, 85.5, 81.7, 77.9, 74.5, 70.7, 66.7, 63.1, 59.0, 55.1, 51.1, 47.1, 42.9, 38.7, 34.5, 30.2, 25.9, 21.2, 16.6, 10.4, 3.5, 0.0},       // hue 324
+	{0.0, 26.9, 33.2, 37.5, 40.8, 43.8, 46.7, 49.6, 52.4, 55.2, 58.0, 60.8, 63.4, 66.1, 68.8, 71.4, 74.0, 76.6, 79.1, 81.7, 84.2, 86.6, 89.1, 91.6, 94.0, 96.4, 98.8, 99.1, 95.7, 92.1, 88.5, 84.6, 80.9, 77.3, 73.2, 69.2, 65.2, 61.3, 57.1, 52.8, 48.7, 44.5, 39.9, 35.7, 31.0, 26.7, 21.9, 15.8, 9.8, 2.9, 0.0},        // hue 326
+	{0.0, 26.8, 33.1, 37.4, 40.6, 43.6, 46.5, 49.4, 52.3, 55.0, 57.8, 60.5, 63.2, 65.9, 68.5, 71.2, 73.7, 76.3, 78.9, 81.4, 83.9, 86.4, 88.8, 91.3, 93.7, 96.1, 98.5, 100.8, 99.7, 95.9, 91.9, 88.3, 84.2, 80.4, 76.1, 71.9, 67.8, 63.7, 59.2, 54.9, 50.5, 46.1, 41.6, 37.0, 32.1, 26.2, 20.5, 14.8, 9.3, 2.9, 0.0},       // hue 328
+	{0.0, 26.7, 33.0, 37.2, 40.5, 43.5, 46.4, 49.2, 52.1, 54.9, 57.6, 60.4, 63.1, 65.7, 68.3, 71.0, 73.6, 76.1, 78.7, 81.2, 83.7, 86.1, 88.6, 91.0, 93.4, 95.9, 98.2, 100.6, 102.9, 100.2, 96.2, 92.2, 87.8, 83.6, 79.4, 75.2, 70.9, 66.1, 61.7, 57.1, 52.7, 47.2, 41.7, 36.2, 30.5, 25.3, 19.6, 14.3, 8.8, 2.9, 0.0},     // hue 330
+	{0.0, 26.6, 32.8, 37.1, 40.4, 43.4, 46.3, 49.1, 51.9, 54.7, 57.5, 60.2, 62.9, 65.5, 68.2, 70.8, 73.4, 75.9, 78.5, 81.0, 83.5, 85.9, 88.4, 90.8, 93.2, 95.6, 98.0, 100.4, 102.7, 105.0, 100.8, 96.7, 92.2, 87.6, 83.2, 78.6, 73.9, 67.9, 62.2, 56.5, 50.9, 45.2, 40.0, 34.4, 29.2, 23.9, 18.6, 13.8, 8.3, 2.9, 0.0},    // hue 332
+	{0.0, 26.5, 32.8, 37.0, 40.3, 43.3, 46.1, 49.0, 51.8, 54.6, 57.4, 60.1, 62.8, 65.4, 68.0, 70.6, 73.2, 75.8, 78.3, 80.8, 83.3, 85.8, 88.2, 90.6, 93.0, 95.4, 97.8, 100.2, 102.5, 104.8, 106.2, 101.7, 96.2, 89.8, 83.4, 77.2, 71.3, 65.5, 59.9, 54.3, 48.9, 43.6, 38.4, 33.1, 27.9, 23.0, 18.2, 12.8, 7.7, 2.4, 0.0},   // hue 334
+	{0.0, 26.4, 32.7, 36.9, 40.2, 43.1, 46.1, 48.9, 51.7, 54.5, 57.3, 60.0, 62.6, 65.3, 67.9, 70.5, 73.1, 75.6, 78.2, 80.7, 83.2, 85.6, 88.1, 90.5, 92.9, 95.3, 97.7, 100.0, 102.3, 104.7, 106.6, 99.9, 93.3, 87.1, 80.7, 74.9, 68.9, 63.4, 57.9, 52.3, 47.0, 41.7, 36.8, 31.9, 27.0, 22.2, 17.3, 12.3, 7.7, 2.4, 0.0},    // hue 336
+	{0.0, 26.4, 32.6, 36.8, 40.1, 43.1, 46.0, 48.8, 51.6, 54.4, 57.2, 59.9, 62.6, 65.2, 67.8, 70.4, 73.0, 75.5, 78.1, 80.6, 83.0, 85.5, 88.0, 90.4, 92.8, 95.2, 97.5, 99.9, 102.2, 104.5, 103.9, 97.2, 90.8, 84.4, 78.5, 72.3, 66.6, 61.2, 55.7, 50.6, 45.5, 40.1, 35.5, 30.7, 25.8, 21.3, 16.4, 11.9, 7.2, 2.4, 0.0},     // hue 338
+	{0.0, 26.3, 32.6, 36.8, 40.1, 43.0, 45.9, 48.8, 51.6, 54.4, 57.1, 59.8, 62.5, 65.1, 67.7, 70.3, 72.9, 75.5, 78.0, 80.5, 83.0, 85.4, 87.9, 90.3, 92.7, 95.1, 97.4, 99.8, 102.1, 104.5, 101.4, 94.6, 88.3, 82.1, 76.1, 70.4, 64.6, 59.3, 54.0, 48.8, 43.7, 39.0, 34.0, 29.5, 24.9, 20.4, 16.0, 11.4, 6.8, 2.4, 0.0},     // hue 340
+	{0.0, 26.3, 32.5, 36.7, 40.0, 43.0, 45.9, 48.7, 51.5, 54.3, 57.0, 59.8, 62.4, 65.1, 67.7, 70.3, 72.9, 75.4, 77.9, 80.4, 82.9, 85.4, 87.8, 90.2, 92.6, 95.0, 97.4, 99.7, 102.1, 104.4, 99.0, 92.5, 86.1, 79.8, 74.0, 68.3, 62.8, 57.6, 52.3, 47.4, 42.6, 37.5, 33.0, 28.7, 24.1, 19.8, 15.5, 11.0, 6.8, 2.2, 0.0},      // hue 342
+	{0.0, 26.2, 32.5, 36.7, 40.0, 42.9, 45.8, 48.7, 51.5, 54.3, 57.0, 59.7, 62.4, 65.1, 67.7, 70.3, 72.8, 75.4, 77.9, 80.4, 82.9, 85.4, 87.8, 90.2, 92.6, 95.0, 97.4, 99.7, 102.1, 103.7, 97.0, 90.2, 84.0, 77.8, 72.1, 66.5, 61.1, 56.0, 50.9, 46.0, 41.2, 36.5, 32.1, 27.5, 23.3, 19.2, 14.7, 10.6, 6.3, 2.2, 0.0},      // hue 344
+	{0.0, 26.2, 32.5, 36.7, 40.0, 42.9, 45.8, 48.7, 51.5, 54.3, 57.0, 59.7, 62.4, 65.1, 67.7, 70.3, 72.9, 75.4, 77.9, 80.4, 82.9, 85.4, 87.8, 90.2, 92.7, 95.0, 97.4, 99.8, 102.1, 101.8, 94.8, 88.3, 82.1, 76.2, 70.2, 64.7, 59.6, 54.4, 49.3, 44.7, 39.8, 35.4, 31.1, 26.9, 22.6, 18.4, 14.3, 10.6, 6.3, 1.8, 0.0},      // hue 346
+	{0.0, 26.2, 32.5, 36.7, 40.0, 42.9, 45.8, 48.7, 51.5, 54.3, 57.0, 59.8, 62.4, 65.1, 67.7, 70.3, 72.9, 75.4, 78.0, 80.5, 83.0, 85.4, 87.9, 90.3, 92.7, 95.1, 97.5, 99.8, 102.2, 99.9, 93.1, 86.5, 80.4, 74.4, 68.8, 63.3, 58.1, 52.9, 48.1, 43.3, 38.8, 34.4, 30.1, 26.1, 21.9, 18.1, 13.9, 10.1, 6.3, 1.8, 0.0},       // hue 348
+	{0.0, 26.2, 32.5, 36.7, 40.0, 43.0, 45.9, 48.7, 51.6, 54.4, 57.1, 59.8, 62.5, 65.2, 67.8, 70.4, 73.0, 75.5, 78.1, 80.6, 83.0, 85.5, 88.0, 90.4, 92.8, 95.2, 97.6, 99.9, 102.3, 98.3, 91.4, 85.0, 78.6, 72.8, 67.2, 61.9, 56.7, 51.7, 46.9, 42.5, 37.9, 33.4, 29.4, 25.4, 21.2, 17.3, 13.5, 9.8, 5.9, 1.8, 0.0},        // hue 350
+	{0.0, 26.2, 32.5, 36.7, 40.1, 43.0, 45.9, 48.8, 51.6, 54.4, 57.2, 59.9, 62.6, 65.3, 67.9, 70.5, 73.1, 75.6, 78.2, 80.7, 83.2, 85.6, 88.1, 90.5, 92.9, 95.3, 97.7, 100.1, 102.4, 96.7, 89.9, 83.4, 77.2, 71.4, 65.7, 60.5, 55.3, 50.6, 45.7, 41.3, 37.0, 32.8, 28.7, 24.7, 20.8, 17.0, 13.2, 9.4, 5.9, 1.8, 0.0},       // hue 352
+	{0.0, 26.2, 32.5, 36.8, 40.1, 43.1, 46.0, 48.9, 51.7, 54.5, 57.3, 60.0, 62.7, 65.4, 68.0, 70.6, 73.2, 75.8, 78.3, 80.8, 83.3, 85.8, 88.3, 90.7, 93.1, 95.5, 97.9, 100.3, 102.6, 95.4, 88.5, 82.0, 75.9, 70.2, 64.5, 59.3, 54.3, 49.5, 44.9, 40.4, 36.0, 31.9, 28.1, 24.1, 20.1, 16.7, 12.8, 9.4, 5.5, 1.8, 0.0},       // hue 354
+	{0.0, 26.3, 32.6, 36.9, 40.2, 43.1, 46.1, 49.0, 51.8, 54.6, 57.4, 60.1, 62.9, 65.5, 68.2, 70.8, 73.4, 75.9, 78.5, 81.1, 83.5, 86.0, 88.5, 90.9, 93.3, 95.8, 98.2, 100.5, 101.4, 94.1, 87.2, 80.7, 74.6, 68.8, 63.3, 58.1, 53.3, 48.5, 43.8, 39.6, 35.2, 31.3, 27.2, 23.5, 19.9, 16.0, 12.5, 9.0, 5.5, 1.8, 0.0},       // hue 356
+	{0.0, 26.3, 32.7, 37.0, 40.3, 43.3, 46.2, 49.1, 52.0, 54.8, 57.6, 60.3, 63.0, 65.7, 68.3, 71.0, 73.6, 76.2, 78.7, 81.3, 83.8, 86.3, 88.8, 91.2, 93.6, 96.1, 98.5, 100.8, 100.3, 92.9, 86.0, 79.6, 73.5, 67.8, 62.2, 57.2, 52.3, 47.5, 43.0, 38.8, 34.7, 30.5, 26.6, 22.9, 19.3, 15.7, 12.2, 8.7, 5.5, 1.8, 0.0},       // hue 358
+	{0.0, 26.4, 32.8, 37.0, 40.4, 43.4, 46.4, 49.2, 52.1, 55.0, 57.7, 60.5, 63.2, 65.9, 68.6, 71.2, 73.9, 76.4, 79.0, 81.5, 84.1, 86.6, 89.1, 91.5, 94.0, 96.4, 98.8, 101.2, 99.4, 91.9, 85.0, 78.6, 72.5, 66.8, 61.4, 56.3, 51.4, 46.8, 42.3, 38.0, 33.9, 30.0, 26.1, 22.6, 19.0, 15.4, 11.9, 8.7, 5.2, 1.8, 0.0},        // hue 360
+}
@@ -47,7 +47,7 @@ func OkLabFromXYZ(x, y, z float64) OkLab {
 
 	p = math.Cbrt(p)
 	q = math.Cbrt(q)
-	p = math.Cbrt(r)
+	r = math.Cbrt(r)
 
 	l, a, b := OkLabMatrix2.MultiplyXYZ(p, q, r).Values()
 	return OkLab{l, a, b}
@@ -69,7 +69,68 @@ func (ok OkLab) ToXYZ() XYZ {
 	return XYZ{x, y, z}
 }
 
+// OkLabFromARGB converts a ARGB color to OkLab.
+func OkLabFromARGB(c ARGB) OkLab {
+	return OkLabFromXYZ(c.ToXYZ().Values())
+}
+
 // Values returns L, a, b values of OkLab Model
 func (c OkLab) Values() (float64, float64, float64) {
 	return c.L, c.A, c.B
 }
+
+// ToARGB converts c to sRGB. Colors outside of sRGB gamut are clipped.
+func (c OkLab) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// DistanceOK returns ΔEOK, the euclidean distance between c and other.
+func (c OkLab) DistanceOK(other OkLab) float64 {
+	dl, da, db := c.L-other.L, c.A-other.A, c.B-other.B
+	return math.Sqrt(dl*dl + da*da + db*db)
+}
+
+// ToOkLch converts c to its cylindrical form.
+func (c OkLab) ToOkLch() OkLch {
+	chroma := math.Hypot(c.A, c.B)
+	hue := 0.0
+	if chroma > 1e-8 {
+		hue = num.NormalizeDegree(num.Degree(math.Atan2(c.B, c.A)))
+	}
+	return OkLch{c.L, chroma, hue}
+}
+
+// OkLch is the cylindrical form of OkLab. H is hue angle in degrees.
+type OkLch struct {
+	L, C, H float64
+}
+
+// NewOkLch creates a OkLch color.
+func NewOkLch(l, c, h float64) OkLch {
+	return OkLch{l, c, h}
+}
+
+// OkLchFromARGB converts a ARGB color to OkLch.
+func OkLchFromARGB(c ARGB) OkLch {
+	return OkLabFromARGB(c).ToOkLch()
+}
+
+// Values returns L, C, h values of OkLch color
+func (c OkLch) Values() (float64, float64, float64) {
+	return c.L, c.C, c.H
+}
+
+// ToOkLab converts c to its rectangular form.
+func (c OkLch) ToOkLab() OkLab {
+	hRad := num.Radian(c.H)
+	return OkLab{c.L, c.C * math.Cos(hRad), c.C * math.Sin(hRad)}
+}
+
+func (c OkLch) ToXYZ() XYZ {
+	return c.ToOkLab().ToXYZ()
+}
+
+// ToARGB converts c to sRGB. Colors outside of sRGB gamut are clipped.
+func (c OkLch) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
@@ -0,0 +1,59 @@
+package color
+
+// P3 is a color in Display P3 color space. Display P3 uses DCI-P3 primaries
+// with D65 white point and sRGB transfer function. R, G, B are gamma encoded
+// and in range [0, 1] for colors inside the P3 gamut.
+type P3 struct {
+	R, G, B float64
+}
+
+var _ digitalColor = (*P3)(nil)
+
+// NewP3 creates a Display P3 color from gamma encoded components.
+func NewP3(r, g, b float64) P3 {
+	return P3{r, g, b}
+}
+
+// P3FromXYZ converts a XYZ color to Display P3.
+func P3FromXYZ(xyz XYZ) P3 {
+	r, g, b := DisplayP3Space.FromXYZ(xyz)
+	return P3{r, g, b}
+}
+
+// P3FromARGB converts a sRGB color to Display P3.
+func P3FromARGB(c ARGB) P3 {
+	return P3FromXYZ(c.ToXYZ())
+}
+
+// Values returns r, g, b values of P3 color
+func (c P3) Values() (float64, float64, float64) {
+	return c.R, c.G, c.B
+}
+
+// ToXYZ returns XYZ color version for c.
+func (c P3) ToXYZ() XYZ {
+	return DisplayP3Space.ToXYZ(c.R, c.G, c.B)
+}
+
+// ToARGB converts c to sRGB. Colors outside of sRGB gamut are clipped.
+func (c P3) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c P3) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c P3) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c P3) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c P3) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
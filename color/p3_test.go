@@ -0,0 +1,16 @@
+package color
+
+import "testing"
+
+func TestP3_FromARGB(t *testing.T) {
+	testFromARGB(t, P3FromARGB, sameValues, []fromARGBCase[P3]{
+		{"White", 0xFFFFFFFF, P3{1, 1, 1}},
+		{"Black", 0xFF000000, P3{0, 0, 0}},
+		{"Red", 0xFFFF0000, P3{0.9175, 0.2003, 0.1387}},
+		{"Green", 0xFF00FF00, P3{0.4584, 0.9853, 0.2983}},
+	})
+}
+
+func TestP3_RoundTrip(t *testing.T) {
+	testRoundTrip(t, P3FromARGB)
+}
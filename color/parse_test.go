@@ -0,0 +1,105 @@
+package color
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    ARGB
+		wantErr bool
+	}{
+		{"Hex", "#336699", 0xFF336699, false},
+		{"Hex Without Hash", "336699", 0xFF336699, false},
+		{"Named", "rebeccapurple", 0xFF663399, false},
+		{"Named Upper Case", " CornflowerBlue ", 0xFF6495ED, false},
+		{"Transparent", "transparent", 0x00000000, false},
+		{"RGB", "rgb(255 0 0 / 50%)", 0x80FF0000, false},
+		{"HSL", "hsl(120deg 100% 50%)", 0xFF00FF00, false},
+		{"OkLch", "oklch(100% 0 0)", 0xFFFFFFFF, false},
+		{"Color", "color(display-p3 0 0 0)", 0xFF000000, false},
+		{"Integer", "0xFF6750A4", 0xFF6750A4, false},
+		{"Integer Lower Case", "0x806750a4", 0x806750A4, false},
+		{"Integer Too Large", "0x1FF6750A4", 0, true},
+		{"Integer Invalid", "0xZZ", 0, true},
+		{"Integer RGB", "0xFF0000", 0xFFFF0000, false},
+		{"Integer Short", "0xFFF", 0, true},
+		{"Integer Invalid Digits", "0xFF67ZZA4", 0, true},
+		{"Empty", "   ", 0, true},
+		{"Unknown Name", "notacolor", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.input, got.HexARGB(), tt.want.HexARGB())
+			}
+		})
+	}
+}
+
+func TestARGB_UnmarshalText(t *testing.T) {
+	var c ARGB
+	if err := c.UnmarshalText([]byte("tomato")); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if c != 0xFFFF6347 {
+		t.Errorf("UnmarshalText(tomato) = %s, want #FFFF6347", c.HexARGB())
+	}
+}
+
+func TestParseStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    ARGB
+		wantErr bool
+	}{
+		{"Hex", "#336699", 0xFF336699, false},
+		{"Hex Alpha", "#33669980", 0x80336699, false},
+		{"Lower Case", "#abcdef", 0xFFABCDEF, false},
+		{"Named", "teal", 0xFF008080, false},
+		{"RGB", "rgb(255 0 0 / 50%)", 0x80FF0000, false},
+		{"Shorthand", "#FFF", 0, true},
+		{"Missing Hash", "336699", 0, true},
+		{"RGB Out Of Range", "rgb(300 0 0)", 0, true},
+		{"RGB Negative Percent", "rgb(-10% 0% 0%)", 0, true},
+		{"Alpha Out Of Range", "rgb(0 0 0 / 1.5)", 0, true},
+		{"Integer", "0x806750A4", 0x806750A4, false},
+		{"Integer RGB", "0xFF0000", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseStrict(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseStrict(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseStrict(%q) = %s, want %s", tt.input, got.HexARGB(), tt.want.HexARGB())
+			}
+		})
+	}
+}
+
+func TestParseWith(t *testing.T) {
+	opts := ParseOptions{AllowShorthand: true, RequireUppercase: true}
+	if _, err := ParseWith("#abc", opts); err == nil {
+		t.Errorf("ParseWith(#abc) error = nil, want upper case error")
+	}
+	if got, err := ParseWith("#ABC", opts); err != nil || got != 0xFFAABBCC {
+		t.Errorf("ParseWith(#ABC) = %s, %v, want #FFAABBCC", got.HexARGB(), err)
+	}
+	if _, err := ParseWith("0xff6750a4", opts); err == nil {
+		t.Errorf("ParseWith(0xff6750a4) error = nil, want upper case error")
+	}
+	if got, err := Parse("rgb(300 0 0 / 2)"); err != nil || got != 0xFFFF0000 {
+		t.Errorf("Parse(rgb(300 0 0 / 2)) = %s, %v, want #FFFF0000", got.HexARGB(), err)
+	}
+}
@@ -0,0 +1,73 @@
+package color
+
+var (
+	// D65_TO_D50 is the Bradford chromatic adaptation from D65 to D50.
+	//
+	// Deprecated: Use Bradford.AdaptationMatrix(IlluminantD65, IlluminantD50)
+	// or XYZ.Adapt.
+	D65_TO_D50 = Bradford.AdaptationMatrix(IlluminantD65, IlluminantD50)
+
+	// D50_TO_D65 is the Bradford chromatic adaptation from D50 to D65.
+	//
+	// Deprecated: Use Bradford.AdaptationMatrix(IlluminantD50, IlluminantD65)
+	// or XYZ.Adapt.
+	D50_TO_D65 = Bradford.AdaptationMatrix(IlluminantD50, IlluminantD65)
+)
+
+// ProPhoto is a color in ProPhoto RGB (ROMM RGB) color space. ProPhoto uses
+// D50 white point, conversions to and from D65 XYZ use Bradford chromatic
+// adaptation. R, G, B are gamma encoded and in range [0, 1].
+type ProPhoto struct {
+	R, G, B float64
+}
+
+var _ digitalColor = (*ProPhoto)(nil)
+
+// NewProPhoto creates a ProPhoto RGB color from gamma encoded components.
+func NewProPhoto(r, g, b float64) ProPhoto {
+	return ProPhoto{r, g, b}
+}
+
+// ProPhotoFromXYZ converts a D65 referenced XYZ color to ProPhoto RGB.
+func ProPhotoFromXYZ(xyz XYZ) ProPhoto {
+	r, g, b := ProPhotoSpace.FromXYZ(xyz)
+	return ProPhoto{r, g, b}
+}
+
+// ProPhotoFromARGB converts a sRGB color to ProPhoto RGB.
+func ProPhotoFromARGB(c ARGB) ProPhoto {
+	return ProPhotoFromXYZ(c.ToXYZ())
+}
+
+// Values returns r, g, b values of ProPhoto color
+func (c ProPhoto) Values() (float64, float64, float64) {
+	return c.R, c.G, c.B
+}
+
+// ToXYZ returns D65 referenced XYZ color version for c.
+func (c ProPhoto) ToXYZ() XYZ {
+	return ProPhotoSpace.ToXYZ(c.R, c.G, c.B)
+}
+
+// ToARGB converts c to sRGB. Colors outside of sRGB gamut are clipped.
+func (c ProPhoto) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c ProPhoto) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c ProPhoto) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c ProPhoto) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c ProPhoto) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
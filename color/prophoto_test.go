@@ -0,0 +1,16 @@
+package color
+
+import "testing"
+
+func TestProPhoto_FromARGB(t *testing.T) {
+	testFromARGB(t, ProPhotoFromARGB, sameValues, []fromARGBCase[ProPhoto]{
+		{"White", 0xFFFFFFFF, ProPhoto{1, 1, 1}},
+		{"Black", 0xFF000000, ProPhoto{0, 0, 0}},
+		{"Red", 0xFFFF0000, ProPhoto{0.7022, 0.2757, 0.1036}},
+		{"Green", 0xFF00FF00, ProPhoto{0.5402, 0.9276, 0.3046}},
+	})
+}
+
+func TestProPhoto_RoundTrip(t *testing.T) {
+	testRoundTrip(t, ProPhotoFromARGB)
+}
@@ -0,0 +1,59 @@
+package color
+
+// Rec2020 is a color in ITU-R BT.2020 color space with D65 white point and
+// the BT.2020 transfer function. R, G, B are gamma encoded and in range [0, 1]
+// for colors inside the Rec.2020 gamut.
+type Rec2020 struct {
+	R, G, B float64
+}
+
+var _ digitalColor = (*Rec2020)(nil)
+
+// NewRec2020 creates a Rec.2020 color from gamma encoded components.
+func NewRec2020(r, g, b float64) Rec2020 {
+	return Rec2020{r, g, b}
+}
+
+// Rec2020FromXYZ converts a XYZ color to Rec.2020.
+func Rec2020FromXYZ(xyz XYZ) Rec2020 {
+	r, g, b := Rec2020Space.FromXYZ(xyz)
+	return Rec2020{r, g, b}
+}
+
+// Rec2020FromARGB converts a sRGB color to Rec.2020.
+func Rec2020FromARGB(c ARGB) Rec2020 {
+	return Rec2020FromXYZ(c.ToXYZ())
+}
+
+// Values returns r, g, b values of Rec2020 color
+func (c Rec2020) Values() (float64, float64, float64) {
+	return c.R, c.G, c.B
+}
+
+// ToXYZ returns XYZ color version for c.
+func (c Rec2020) ToXYZ() XYZ {
+	return Rec2020Space.ToXYZ(c.R, c.G, c.B)
+}
+
+// ToARGB converts c to sRGB. Colors outside of sRGB gamut are clipped.
+func (c Rec2020) ToARGB() ARGB {
+	return c.ToXYZ().ToARGB()
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c Rec2020) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+func (c Rec2020) ToLab() Lab {
+	return c.ToXYZ().ToLab()
+}
+
+func (c Rec2020) ToHct() Hct {
+	return c.ToXYZ().ToHct()
+}
+
+func (c Rec2020) ToCam() *Cam16 {
+	return c.ToXYZ().ToCam()
+}
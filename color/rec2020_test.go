@@ -0,0 +1,15 @@
+package color
+
+import "testing"
+
+func TestRec2020_FromARGB(t *testing.T) {
+	testFromARGB(t, Rec2020FromARGB, sameValues, []fromARGBCase[Rec2020]{
+		{"White", 0xFFFFFFFF, Rec2020{1, 1, 1}},
+		{"Black", 0xFF000000, Rec2020{0, 0, 0}},
+		{"Red", 0xFFFF0000, Rec2020{0.7920, 0.2310, 0.0738}},
+	})
+}
+
+func TestRec2020_RoundTrip(t *testing.T) {
+	testRoundTrip(t, Rec2020FromARGB)
+}
@@ -0,0 +1,173 @@
+package color
+
+import (
+	"errors"
+
+	"github.com/Nadim147c/material/num"
+)
+
+// RGBColorSpace describes an additive RGB color space by its primaries, white
+// point and transfer function. Conversions from and to XYZ use D65 white point
+// like the rest of this package. If the space has a different white point,
+// colors are adapted with Bradford transform.
+type RGBColorSpace struct {
+	// Name is a human readable name of the color space
+	Name string
+	// Red, Green and Blue are the chromaticity of the primaries
+	Red, Green, Blue Chromaticity
+	// WhitePoint is the chromaticity of the reference white
+	WhitePoint Chromaticity
+	// Decode converts a gamma encoded component to linear light. Both input and
+	// output are normalized to [0, 1].
+	Decode func(float64) float64
+	// Encode converts a linear light component to gamma encoded form.
+	Encode func(float64) float64
+
+	toXYZ   num.Matrix3 // linear RGB to own white XYZ (Y = 1)
+	fromXYZ num.Matrix3
+	toD65   num.Matrix3 // linear RGB to D65 XYZ (Y = 100)
+	fromD65 num.Matrix3
+}
+
+// ErrDegeneratePrimaries is returned when primaries of a RGBColorSpace do not
+// form a triangle.
+var ErrDegeneratePrimaries = errors.New("primaries do not form a valid gamut")
+
+func identityTransfer(v float64) float64 {
+	return v
+}
+
+// NewRGBColorSpace creates a RGBColorSpace and computes its XYZ matrices. If
+// decode or encode is nil, the space is linear.
+func NewRGBColorSpace(
+	name string,
+	red, green, blue, white Chromaticity,
+	decode, encode func(float64) float64,
+) (*RGBColorSpace, error) {
+	if decode == nil {
+		decode = identityTransfer
+	}
+	if encode == nil {
+		encode = identityTransfer
+	}
+
+	r, g, b := red.ToXYZ(1), green.ToXYZ(1), blue.ToXYZ(1)
+	primaries := num.NewMatrix3(
+		r.X, g.X, b.X,
+		r.Y, g.Y, b.Y,
+		r.Z, g.Z, b.Z,
+	)
+	inv, ok := primaries.Inverse()
+	if !ok {
+		return nil, ErrDegeneratePrimaries
+	}
+
+	w := white.ToXYZ(1)
+	s := inv.MultiplyXYZ(w.Values())
+	toXYZ := primaries.MultiplyMatrix(num.NewMatrix3(
+		s[0], 0, 0,
+		0, s[1], 0,
+		0, 0, s[2],
+	))
+	fromXYZ, ok := toXYZ.Inverse()
+	if !ok {
+		return nil, ErrDegeneratePrimaries
+	}
+
+	toD65 := toXYZ
+	if white != ChromaticityD65 {
+		adapt := Bradford.AdaptationMatrix(w, ChromaticityD65.ToXYZ(1))
+		toD65 = adapt.MultiplyMatrix(toXYZ)
+	}
+	toD65 = toD65.MultiplyMatrix(num.NewMatrix3(100, 0, 0, 0, 100, 0, 0, 0, 100))
+	fromD65, _ := toD65.Inverse()
+
+	return &RGBColorSpace{
+		Name:       name,
+		Red:        red,
+		Green:      green,
+		Blue:       blue,
+		WhitePoint: white,
+		Decode:     decode,
+		Encode:     encode,
+		toXYZ:      toXYZ,
+		fromXYZ:    fromXYZ,
+		toD65:      toD65,
+		fromD65:    fromD65,
+	}, nil
+}
+
+// mustRGBColorSpace is like NewRGBColorSpace but panics on error.
+func mustRGBColorSpace(
+	name string,
+	red, green, blue, white Chromaticity,
+	decode, encode func(float64) float64,
+) *RGBColorSpace {
+	space, err := NewRGBColorSpace(name, red, green, blue, white, decode, encode)
+	if err != nil {
+		panic(err)
+	}
+	return space
+}
+
+var (
+	// SRGBSpace is the standard sRGB color space.
+	SRGBSpace = mustRGBColorSpace("srgb",
+		Chromaticity{0.64, 0.33}, Chromaticity{0.30, 0.60}, Chromaticity{0.15, 0.06},
+		ChromaticityD65, srgbDecode, srgbEncode)
+	// LinearSRGBSpace is sRGB without transfer function.
+	LinearSRGBSpace = mustRGBColorSpace("srgb-linear",
+		Chromaticity{0.64, 0.33}, Chromaticity{0.30, 0.60}, Chromaticity{0.15, 0.06},
+		ChromaticityD65, nil, nil)
+	// DisplayP3Space is the Display P3 color space.
+	DisplayP3Space = mustRGBColorSpace("display-p3",
+		Chromaticity{0.680, 0.320}, Chromaticity{0.265, 0.690}, Chromaticity{0.150, 0.060},
+		ChromaticityD65, srgbDecode, srgbEncode)
+	// Rec2020Space is the ITU-R BT.2020 color space.
+	Rec2020Space = mustRGBColorSpace("rec2020",
+		Chromaticity{0.708, 0.292}, Chromaticity{0.170, 0.797}, Chromaticity{0.131, 0.046},
+		ChromaticityD65, rec2020Decode, rec2020Encode)
+	// AdobeRGBSpace is the Adobe RGB (1998) color space.
+	AdobeRGBSpace = mustRGBColorSpace("a98-rgb",
+		Chromaticity{0.64, 0.33}, Chromaticity{0.21, 0.71}, Chromaticity{0.15, 0.06},
+		ChromaticityD65, adobeDecode, adobeEncode)
+	// ProPhotoSpace is the ProPhoto RGB (ROMM RGB) color space.
+	ProPhotoSpace = mustRGBColorSpace("prophoto-rgb",
+		Chromaticity{0.734699, 0.265301}, Chromaticity{0.159597, 0.840403}, Chromaticity{0.036598, 0.000105},
+		ChromaticityD50, prophotoDecode, prophotoEncode)
+)
+
+// ToXYZMatrix returns the matrix converting linear RGB of this space to XYZ
+// relative to the space's own white point, normalized so white has Y = 1.
+func (s *RGBColorSpace) ToXYZMatrix() num.Matrix3 {
+	return s.toXYZ
+}
+
+// FromXYZMatrix returns the inverse of ToXYZMatrix.
+func (s *RGBColorSpace) FromXYZMatrix() num.Matrix3 {
+	return s.fromXYZ
+}
+
+// LinearToXYZ converts linear r, g, b components to D65 referenced XYZ.
+func (s *RGBColorSpace) LinearToXYZ(r, g, b float64) XYZ {
+	x, y, z := s.toD65.MultiplyXYZ(r, g, b).Values()
+	return XYZ{x, y, z}
+}
+
+// LinearFromXYZ converts D65 referenced XYZ to linear r, g, b components.
+// Values are not clamped.
+func (s *RGBColorSpace) LinearFromXYZ(xyz XYZ) (float64, float64, float64) {
+	return s.fromD65.MultiplyXYZ(xyz.Values()).Values()
+}
+
+// ToXYZ converts gamma encoded r, g, b components to D65 referenced XYZ.
+func (s *RGBColorSpace) ToXYZ(r, g, b float64) XYZ {
+	return s.LinearToXYZ(s.Decode(r), s.Decode(g), s.Decode(b))
+}
+
+// FromXYZ converts D65 referenced XYZ to gamma encoded r, g, b components.
+// Values are not clamped.
+func (s *RGBColorSpace) FromXYZ(xyz XYZ) (float64, float64, float64) {
+	r, g, b := s.LinearFromXYZ(xyz)
+	return s.Encode(r), s.Encode(g), s.Encode(b)
+}
@@ -0,0 +1,107 @@
+package color
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestRGBColorSpace_SRGBMatrix(t *testing.T) {
+	// Matrix derived from primaries must match the hardcoded sRGB matrix. The
+	// hardcoded one uses a slightly different D65 white, so only 3 digits match.
+	m := SRGBSpace.ToXYZMatrix()
+	for i := range 3 {
+		for j := range 3 {
+			if got, want := m[i][j], SRGB_TO_XYZ[i][j]; math.Abs(got-want) > 0.001 {
+				t.Errorf("SRGBSpace.ToXYZMatrix()[%d][%d] = %f, want %f", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestRGBColorSpace_White(t *testing.T) {
+	spaces := []*RGBColorSpace{
+		SRGBSpace, LinearSRGBSpace, DisplayP3Space, Rec2020Space, AdobeRGBSpace, ProPhotoSpace,
+	}
+	for _, space := range spaces {
+		t.Run(space.Name, func(t *testing.T) {
+			// White of every space is adapted to D65
+			got := space.ToXYZ(1, 1, 1)
+			want := ChromaticityD65.ToXYZ(100)
+			if !almostEqual(got.X, want.X) || !almostEqual(got.Y, want.Y) || !almostEqual(got.Z, want.Z) {
+				t.Errorf("%s white = %v, want %v", space.Name, got, want)
+			}
+		})
+	}
+}
+
+func TestRGBColorSpace_RoundTrip(t *testing.T) {
+	for _, tt := range ColorTestCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r, g, b := SRGBSpace.FromXYZ(tt.ARGB.ToXYZ())
+			if got := NewXYZ(SRGBSpace.ToXYZ(r, g, b).Values()).ToARGB(); got != tt.ARGB {
+				t.Errorf("SRGBSpace(%s) Round Trip = %s", tt.ARGB.HexRGB(), got.HexRGB())
+			}
+			// Components are within half a step of the 8-bit value
+			wr, wg, wb := tt.ARGB.Red(), tt.ARGB.Green(), tt.ARGB.Blue()
+			if math.Abs(r*255-float64(wr)) > 0.5 || math.Abs(g*255-float64(wg)) > 0.5 ||
+				math.Abs(b*255-float64(wb)) > 0.5 {
+				t.Errorf("SRGBSpace.FromXYZ(%s) = (%f, %f, %f)", tt.ARGB.HexRGB(), r, g, b)
+			}
+		})
+	}
+}
+
+func TestNewRGBColorSpace_Degenerate(t *testing.T) {
+	p := Chromaticity{0.3, 0.3}
+	_, err := NewRGBColorSpace("bad", p, p, p, ChromaticityD65, nil, nil)
+	if !errors.Is(err, ErrDegeneratePrimaries) {
+		t.Errorf("NewRGBColorSpace() error = %v, want %v", err, ErrDegeneratePrimaries)
+	}
+}
+
+func TestRGBColorSpace_Contains(t *testing.T) {
+	if !SRGBSpace.ContainsChromaticity(ChromaticityD65) {
+		t.Errorf("sRGB must contain D65 white")
+	}
+	if SRGBSpace.ContainsChromaticity(Chromaticity{0.68, 0.32}) {
+		t.Errorf("sRGB must not contain P3 red primary")
+	}
+	if !DisplayP3Space.ContainsChromaticity(Chromaticity{0.64, 0.33}) {
+		t.Errorf("P3 must contain sRGB red primary")
+	}
+
+	p3Red := NewP3(1, 0, 0).ToXYZ()
+	if SRGBSpace.ContainsXYZ(p3Red) {
+		t.Errorf("sRGB must not contain P3 red")
+	}
+	if !DisplayP3Space.ContainsXYZ(ARGB(0xFFFF0000).ToXYZ()) {
+		t.Errorf("P3 must contain sRGB red")
+	}
+	if !ProPhotoSpace.ContainsXYZ(ARGB(0xFF00FF00).ToXYZ()) {
+		t.Errorf("ProPhoto must contain sRGB green")
+	}
+}
+
+func TestGamutCoverage(t *testing.T) {
+	tests := []struct {
+		space, reference *RGBColorSpace
+		want             float64
+	}{
+		{SRGBSpace, SRGBSpace, 100},
+		{DisplayP3Space, SRGBSpace, 100},
+		{Rec2020Space, SRGBSpace, 100},
+		// P3 red is slightly outside of Rec2020
+		{Rec2020Space, DisplayP3Space, 99.98},
+		{SRGBSpace, DisplayP3Space, 73.72},
+		{SRGBSpace, Rec2020Space, 52.89},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.space.Name+"/"+tt.reference.Name, func(t *testing.T) {
+			if got := GamutCoverage(tt.space, tt.reference); !almostEqual(got, tt.want) {
+				t.Errorf("GamutCoverage() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package color
+
+import (
+	"container/list"
+	"math"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Nadim147c/material/num"
+)
+
+// DefaultSolverCacheSize is the size used by NewSolverCache when the given
+// size is not positive. It is enough for the palettes of a few themes.
+const DefaultSolverCacheSize = 4096
+
+// solverCacheScale is the inverse of the step hue, chroma and tone are
+// rounded to before they are used as a cache key.
+const solverCacheScale = 1000
+
+// solverKey is a hue, chroma and tone quantized to 1/solverCacheScale.
+type solverKey struct {
+	hue, chroma, tone int64
+}
+
+func newSolverKey(hue, chroma, tone float64) solverKey {
+	return solverKey{
+		hue:    int64(math.Round(num.NormalizeDegree(hue) * solverCacheScale)),
+		chroma: int64(math.Round(chroma * solverCacheScale)),
+		tone:   int64(math.Round(tone * solverCacheScale)),
+	}
+}
+
+type solverEntry struct {
+	key  solverKey
+	argb ARGB
+}
+
+// CacheStats are the lookup counters of a SolverCache.
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// HitRate returns the fraction of lookups that were served from the cache, or
+// 0 if there was no lookup.
+func (s CacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// SolverCache is a least recently used cache of HCT to ARGB solutions. Hue,
+// chroma and tone are rounded to 0.001 and the rounded values are solved, so a
+// result does not depend on which caller filled the cache. It is safe for
+// concurrent use.
+type SolverCache struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List // front is the most recently used
+	items map[solverKey]*list.Element
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// NewSolverCache creates a SolverCache holding up to size colors. If size is
+// not positive, DefaultSolverCacheSize is used.
+func NewSolverCache(size int) *SolverCache {
+	if size <= 0 {
+		size = DefaultSolverCacheSize
+	}
+	return &SolverCache{
+		size:  size,
+		order: list.New(),
+		items: make(map[solverKey]*list.Element, size),
+	}
+}
+
+// Solve returns the ARGB color of the given hue, chroma and tone, solving it
+// with DefaultSolverOptions if it is not cached.
+func (c *SolverCache) Solve(hue, chroma, tone float64) ARGB {
+	key := newSolverKey(hue, chroma, tone)
+
+	c.mu.Lock()
+	if elem, ok := c.items[key]; ok {
+		c.order.MoveToFront(elem)
+		argb := elem.Value.(*solverEntry).argb
+		c.mu.Unlock()
+		c.hits.Add(1)
+		return argb
+	}
+	c.mu.Unlock()
+	c.misses.Add(1)
+
+	// Solve outside of the lock, concurrent callers may solve the same key
+	// but the result is identical.
+	argb := SolveToARGB(
+		float64(key.hue)/solverCacheScale,
+		float64(key.chroma)/solverCacheScale,
+		float64(key.tone)/solverCacheScale,
+		DefaultSolverOptions,
+	)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.items[key]; ok {
+		c.order.MoveToFront(elem)
+		return argb
+	}
+	c.items[key] = c.order.PushFront(&solverEntry{key, argb})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*solverEntry).key)
+	}
+	return argb
+}
+
+// Len returns the number of cached colors.
+func (c *SolverCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Stats returns the hit and miss counters of c.
+func (c *SolverCache) Stats() CacheStats {
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+}
+
+// Reset removes all cached colors and zeroes the counters.
+func (c *SolverCache) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	clear(c.items)
+	c.hits.Store(0)
+	c.misses.Store(0)
+}
+
+var solverCache atomic.Pointer[SolverCache]
+
+// SetSolverCache sets the process wide cache used by NewHct and Hct.ToARGB.
+// Passing nil disables caching, which is the default. It is safe to call
+// concurrently.
+func SetSolverCache(c *SolverCache) {
+	solverCache.Store(c)
+}
+
+// GetSolverCache returns the process wide cache, or nil if caching is
+// disabled.
+func GetSolverCache() *SolverCache {
+	return solverCache.Load()
+}
+
+// solveHct solves hue, chroma and tone with the process wide cache if it is
+// set.
+func solveHct(hue, chroma, tone float64) ARGB {
+	if c := solverCache.Load(); c != nil {
+		return c.Solve(hue, chroma, tone)
+	}
+	return SolveToARGB(hue, chroma, tone, DefaultSolverOptions)
+}
@@ -0,0 +1,52 @@
+package color
+
+import "testing"
+
+func TestSolverCache(t *testing.T) {
+	c := NewSolverCache(2)
+
+	want := SolveToARGB(270, 40, 50, DefaultSolverOptions)
+	if got := c.Solve(270, 40, 50); got != want {
+		t.Errorf("Solve() = %s, want %s", got.HexRGB(), want.HexRGB())
+	}
+	if got := c.Solve(270, 40, 50); got != want {
+		t.Errorf("cached Solve() = %s, want %s", got.HexRGB(), want.HexRGB())
+	}
+	if stats := c.Stats(); stats.Hits != 1 || stats.Misses != 1 || !almostEqual(stats.HitRate(), 0.5) {
+		t.Errorf("Stats() = %+v, want 1 hit and 1 miss", stats)
+	}
+
+	// The least recently used color is evicted
+	c.Solve(120, 40, 50)
+	c.Solve(270, 40, 50)
+	c.Solve(30, 40, 50)
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", c.Len())
+	}
+	c.Solve(270, 40, 50)
+	c.Solve(120, 40, 50)
+	if stats := c.Stats(); stats.Hits != 3 || stats.Misses != 4 {
+		t.Errorf("Stats() = %+v, want 3 hits and 4 misses", stats)
+	}
+
+	c.Reset()
+	if c.Len() != 0 || c.Stats() != (CacheStats{}) {
+		t.Errorf("Reset() left %d colors and %+v", c.Len(), c.Stats())
+	}
+}
+
+func TestSetSolverCache(t *testing.T) {
+	c := NewSolverCache(0)
+	SetSolverCache(c)
+	t.Cleanup(func() { SetSolverCache(nil) })
+
+	for range 3 {
+		NewHct(180, 30, 60)
+	}
+	if stats := c.Stats(); stats.Hits != 2 || stats.Misses != 1 {
+		t.Errorf("Stats() = %+v, want 2 hits and 1 miss", stats)
+	}
+	if GetSolverCache() != c {
+		t.Errorf("GetSolverCache() did not return the set cache")
+	}
+}
@@ -0,0 +1,90 @@
+package color
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// achromaticChroma is the chroma below which a color has no meaningful hue.
+const achromaticChroma = 5.0
+
+// hctColor is a color with its Hct, so sorting converts every color once.
+type hctColor struct {
+	argb ARGB
+	hct  Hct
+}
+
+// sortByHct sorts colors in place by cmp of their Hct, keeping the order of
+// equal colors.
+func sortByHct(colors []ARGB, cmp func(a, b Hct) int) {
+	keyed := make([]hctColor, len(colors))
+	for i, c := range colors {
+		keyed[i] = hctColor{c, c.ToHct()}
+	}
+	slices.SortStableFunc(keyed, func(a, b hctColor) int {
+		return cmp(a.hct, b.hct)
+	})
+	for i, k := range keyed {
+		colors[i] = k.argb
+	}
+}
+
+// SortByTone sorts colors in place from dark to light by HCT tone.
+func SortByTone(colors []ARGB) {
+	sortByHct(colors, func(a, b Hct) int {
+		return cmp.Compare(a.Tone, b.Tone)
+	})
+}
+
+// SortByHue sorts colors in place by HCT hue, and colors with the same hue by
+// tone. Nearly achromatic colors have no meaningful hue, so they are placed
+// at the end ordered by tone.
+func SortByHue(colors []ARGB) {
+	sortByHct(colors, func(a, b Hct) int {
+		grayA, grayB := a.Chroma < achromaticChroma, b.Chroma < achromaticChroma
+		switch {
+		case grayA && grayB:
+			return cmp.Compare(a.Tone, b.Tone)
+		case grayA:
+			return 1
+		case grayB:
+			return -1
+		}
+		return cmp.Or(cmp.Compare(a.Hue, b.Hue), cmp.Compare(a.Tone, b.Tone))
+	})
+}
+
+// SortSmooth orders colors in place so that each color is followed by the
+// closest remaining color in CAM16-UCS, starting from the darkest one. This
+// greedy solution of the traveling salesman problem gives smooth ramps for
+// swatch rows, e.g. from quantizer output. It takes O(n²) time.
+func SortSmooth(colors []ARGB) {
+	if len(colors) < 3 {
+		SortByTone(colors)
+		return
+	}
+
+	ucs := make([]Cam16UCS, len(colors))
+	start := 0
+	for i, c := range colors {
+		ucs[i] = Cam16UCSFromARGB(c)
+		if ucs[i].Jstar < ucs[start].Jstar {
+			start = i
+		}
+	}
+
+	// Move visited colors to the front of both slices
+	colors[0], colors[start] = colors[start], colors[0]
+	ucs[0], ucs[start] = ucs[start], ucs[0]
+	for i := 1; i < len(colors); i++ {
+		nearest, nearestDist := i, math.Inf(1)
+		for j := i; j < len(colors); j++ {
+			if dist := ucs[i-1].Distance(ucs[j]); dist < nearestDist {
+				nearest, nearestDist = j, dist
+			}
+		}
+		colors[i], colors[nearest] = colors[nearest], colors[i]
+		ucs[i], ucs[nearest] = ucs[nearest], ucs[i]
+	}
+}
@@ -0,0 +1,52 @@
+package color
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSortByTone(t *testing.T) {
+	colors := []ARGB{0xFFFFFFFF, 0xFF6750A4, 0xFF000000, 0xFF808080}
+	SortByTone(colors)
+	want := []ARGB{0xFF000000, 0xFF6750A4, 0xFF808080, 0xFFFFFFFF}
+	if !slices.Equal(colors, want) {
+		t.Errorf("SortByTone() = %v, want %v", colors, want)
+	}
+}
+
+func TestSortByHue(t *testing.T) {
+	colors := []ARGB{0xFF808080, 0xFF0000FF, 0xFF00FF00, 0xFFFF0000, 0xFF000000, 0xFF800000}
+	SortByHue(colors)
+	want := []ARGB{0xFF800000, 0xFFFF0000, 0xFF00FF00, 0xFF0000FF, 0xFF000000, 0xFF808080}
+	if !slices.Equal(colors, want) {
+		t.Errorf("SortByHue() = %v, want %v", colors, want)
+	}
+}
+
+func TestSortSmooth(t *testing.T) {
+	// Two ramps shuffled together
+	colors := []ARGB{
+		0xFF0000FF, 0xFFFFCCCC, 0xFF000066, 0xFFFF0000, 0xFF6666FF, 0xFF660000, 0xFFCCCCFF, 0xFFFF6666,
+	}
+	shuffled := slices.Clone(colors)
+	SortSmooth(colors)
+
+	if colors[0] != 0xFF000066 {
+		t.Errorf("SortSmooth()[0] = %s, want darkest #000066", colors[0].HexRGB())
+	}
+	want := []ARGB{0xFF000066, 0xFF0000FF, 0xFF6666FF, 0xFFCCCCFF}
+	if !slices.Equal(colors[:4], want) {
+		t.Errorf("SortSmooth() = %v, want blue ramp %v first", colors, want)
+	}
+
+	pathLength := func(colors []ARGB) float64 {
+		var total float64
+		for i := 1; i < len(colors); i++ {
+			total += Cam16UCSFromARGB(colors[i-1]).Distance(Cam16UCSFromARGB(colors[i]))
+		}
+		return total
+	}
+	if total, shuffledTotal := pathLength(colors), pathLength(shuffled); total >= shuffledTotal {
+		t.Errorf("SortSmooth() path length = %.1f, want shorter than %.1f", total, shuffledTotal)
+	}
+}
@@ -0,0 +1,62 @@
+package color
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// SQLStorage selects how ARGB is stored in a database by Value.
+type SQLStorage int32
+
+const (
+	// SQLStorageHex stores colors as #RRGGBBAA text.
+	SQLStorageHex SQLStorage = iota
+	// SQLStorageInt stores colors as packed 0xAARRGGBB integer.
+	SQLStorageInt
+)
+
+var sqlStorage atomic.Int32
+
+// SetSQLStorage sets the storage mode used by ARGB.Value. It is safe to call
+// concurrently. The default is SQLStorageHex.
+func SetSQLStorage(storage SQLStorage) {
+	sqlStorage.Store(int32(storage))
+}
+
+// GetSQLStorage returns the storage mode used by ARGB.Value.
+func GetSQLStorage() SQLStorage {
+	return SQLStorage(sqlStorage.Load())
+}
+
+// Value implements driver.Valuer. The stored form depends on GetSQLStorage.
+func (c ARGB) Value() (driver.Value, error) {
+	switch GetSQLStorage() {
+	case SQLStorageInt:
+		return int64(c), nil
+	default:
+		return c.HexRGBA(), nil
+	}
+}
+
+// Scan implements sql.Scanner. It accepts integers and every color string
+// supported by Parse, regardless of the storage mode.
+func (c *ARGB) Scan(src any) error {
+	switch v := src.(type) {
+	case int64:
+		if v < 0 || v > 0xFFFFFFFF {
+			return fmt.Errorf("color value out of range: %d", v)
+		}
+		*c = ARGB(v)
+		return nil
+	case string:
+		return c.UnmarshalText([]byte(v))
+	case []byte:
+		return c.UnmarshalText(v)
+	case nil:
+		return errors.New("can not scan NULL into ARGB")
+	default:
+		return fmt.Errorf("can not scan %T into ARGB", src)
+	}
+}
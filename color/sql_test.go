@@ -0,0 +1,52 @@
+package color
+
+import "testing"
+
+func TestARGB_Value(t *testing.T) {
+	c := ARGB(0x80FF8000)
+
+	got, err := c.Value()
+	if err != nil || got != "#FF800080" {
+		t.Errorf("Value() = %v, %v, want #FF800080", got, err)
+	}
+
+	SetSQLStorage(SQLStorageInt)
+	defer SetSQLStorage(SQLStorageHex)
+
+	got, err = c.Value()
+	if err != nil || got != int64(0x80FF8000) {
+		t.Errorf("Value() = %v, %v, want %d", got, err, int64(0x80FF8000))
+	}
+}
+
+func TestARGB_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    ARGB
+		wantErr bool
+	}{
+		{"Int", int64(0xFF336699), 0xFF336699, false},
+		{"String", "#33669980", 0x80336699, false},
+		{"Bytes", []byte("rgb(255 0 0)"), 0xFFFF0000, false},
+		{"Named", "teal", 0xFF008080, false},
+		{"Negative", int64(-1), 0, true},
+		{"Too Large", int64(1 << 33), 0, true},
+		{"Null", nil, 0, true},
+		{"Float", 1.5, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ARGB
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan(%v) error = %v, wantErr %v", tt.src, err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Scan(%v) = %s, want %s", tt.src, got.HexARGB(), tt.want.HexARGB())
+			}
+		})
+	}
+}
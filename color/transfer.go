@@ -0,0 +1,120 @@
+package color
+
+import "math"
+
+// srgbDecode converts a gamma encoded sRGB component to linear light. Both
+// input and output are normalized to [0, 1]. Negative values are mirrored so
+// that out of gamut colors survive the round trip.
+func srgbDecode(encoded float64) float64 {
+	abs := math.Abs(encoded)
+	if abs <= 0.04045 {
+		return encoded / 12.92
+	}
+	return math.Copysign(math.Pow((abs+0.055)/1.055, 2.4), encoded)
+}
+
+// srgbEncode converts a linear light component to gamma encoded sRGB. Both
+// input and output are normalized to [0, 1]. Negative values are mirrored.
+func srgbEncode(linear float64) float64 {
+	abs := math.Abs(linear)
+	if abs <= 0.0031308 {
+		return linear * 12.92
+	}
+	return math.Copysign(1.055*math.Pow(abs, 1.0/2.4)-0.055, linear)
+}
+
+const (
+	rec2020Alpha = 1.09929682680944
+	rec2020Beta  = 0.018053968510807
+)
+
+// rec2020Decode converts a gamma encoded Rec.2020 component to linear light.
+// Both input and output are normalized to [0, 1].
+func rec2020Decode(encoded float64) float64 {
+	abs := math.Abs(encoded)
+	if abs < rec2020Beta*4.5 {
+		return encoded / 4.5
+	}
+	return math.Copysign(math.Pow((abs+rec2020Alpha-1)/rec2020Alpha, 1/0.45), encoded)
+}
+
+// rec2020Encode converts a linear light component to gamma encoded Rec.2020.
+// Both input and output are normalized to [0, 1].
+func rec2020Encode(linear float64) float64 {
+	abs := math.Abs(linear)
+	if abs < rec2020Beta {
+		return linear * 4.5
+	}
+	return math.Copysign(rec2020Alpha*math.Pow(abs, 0.45)-(rec2020Alpha-1), linear)
+}
+
+// adobeGamma is the exponent of Adobe RGB (1998) transfer function.
+const adobeGamma = 563.0 / 256.0
+
+// adobeDecode converts a gamma encoded Adobe RGB component to linear light.
+// Both input and output are normalized to [0, 1].
+func adobeDecode(encoded float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(encoded), adobeGamma), encoded)
+}
+
+// adobeEncode converts a linear light component to gamma encoded Adobe RGB.
+// Both input and output are normalized to [0, 1].
+func adobeEncode(linear float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(linear), 1/adobeGamma), linear)
+}
+
+// prophotoEt is the linear segment threshold of ProPhoto RGB.
+const prophotoEt = 1.0 / 512.0
+
+// prophotoDecode converts a gamma encoded ProPhoto RGB component to linear
+// light. Both input and output are normalized to [0, 1].
+func prophotoDecode(encoded float64) float64 {
+	abs := math.Abs(encoded)
+	if abs < prophotoEt*16 {
+		return encoded / 16
+	}
+	return math.Copysign(math.Pow(abs, 1.8), encoded)
+}
+
+// prophotoEncode converts a linear light component to gamma encoded ProPhoto
+// RGB. Both input and output are normalized to [0, 1].
+func prophotoEncode(linear float64) float64 {
+	abs := math.Abs(linear)
+	if abs < prophotoEt {
+		return linear * 16
+	}
+	return math.Copysign(math.Pow(abs, 1/1.8), linear)
+}
+
+// SMPTE ST 2084 (PQ) constants
+const (
+	pqM1 = 2610.0 / 16384.0
+	pqM2 = 2523.0 / 4096.0 * 128.0
+	pqC1 = 3424.0 / 4096.0
+	pqC2 = 2413.0 / 4096.0 * 32.0
+	pqC3 = 2392.0 / 4096.0 * 32.0
+)
+
+// pqEncode applies the PQ inverse EOTF. linear is absolute luminance
+// normalized by 10000 cd/m² and the result is in range [0, 1].
+func pqEncode(linear float64) float64 {
+	return pqEncodeWith(linear, pqM2)
+}
+
+// pqEncodeWith is pqEncode with a custom m2 exponent.
+func pqEncodeWith(linear, m2 float64) float64 {
+	ym1 := math.Pow(max(linear, 0), pqM1)
+	return math.Pow((pqC1+pqC2*ym1)/(1+pqC3*ym1), m2)
+}
+
+// pqDecode applies the PQ EOTF. It returns absolute luminance normalized by
+// 10000 cd/m².
+func pqDecode(encoded float64) float64 {
+	return pqDecodeWith(encoded, pqM2)
+}
+
+// pqDecodeWith is pqDecode with a custom m2 exponent.
+func pqDecodeWith(encoded, m2 float64) float64 {
+	ep := math.Pow(max(encoded, 0), 1/m2)
+	return math.Pow(max(ep-pqC1, 0)/(pqC2-pqC3*ep), 1/pqM1)
+}
@@ -8,7 +8,9 @@ import (
 
 // XYZ is color in XYZ color space
 type XYZ struct {
-	X, Y, Z float64
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
 }
 
 // Ensure Color implements the color.Color interface
@@ -38,13 +40,18 @@ func (c XYZ) ToXYZ() XYZ {
 	return c
 }
 
+// ToLab converts c to Lab with D65 white point.
 func (c XYZ) ToLab() Lab {
-	x, y, z := c.Values()
+	return c.ToLabIn(IlluminantD65)
+}
 
-	// x,y,z value of WhitePointD65 cordinate
-	wx, wy, wz := WhitePointD65.Values()
+// ToLabIn converts c to Lab relative to white, e.g. IlluminantD50. c and white
+// must be referred to the same illuminant; no chromatic adaptation is done.
+func (c XYZ) ToLabIn(white XYZ) Lab {
+	x, y, z := c.Values()
+	wx, wy, wz := white.Values()
 
-	// Normalize x,y,z with WhitePointD65
+	// Normalize x,y,z with the white point
 	nx, ny, nz := x/wx, y/wy, z/wz
 
 	fx, fy, fz := LabFunc(nx), LabFunc(ny), LabFunc(nz)
@@ -56,7 +63,7 @@ func (c XYZ) ToLab() Lab {
 }
 
 func (c XYZ) ToCam() *Cam16 {
-	return Cam16FromXyzInEnv(c, &DefaultEnviroment)
+	return Cam16FromXyzInEnv(c, &DefaultEnvironment)
 }
 
 func (c XYZ) ToHct() Hct {
@@ -79,6 +86,11 @@ func (c XYZ) LStar() float64 {
 	return LstarFromY(c.Y)
 }
 
+// LStarIn returns the L* value of c relative to white.
+func (c XYZ) LStarIn(white XYZ) float64 {
+	return LstarFromY(c.Y / white.Y * 100)
+}
+
 // Linearized takes component (uint8) that represents R/G/B channel.
 // Returns 0.0 <= output <= 1.0, color channel converted to linear RGB space
 func Linearized(component uint8) float64 {
@@ -21,3 +21,25 @@ func TestXYZColor_ToLab(t *testing.T) {
 		})
 	}
 }
+
+func TestXYZColor_ToLabIn(t *testing.T) {
+	for name, white := range Illuminants {
+		t.Run(name, func(t *testing.T) {
+			if got := white.ToLabIn(white); !sameLab(got, Lab{100, 0, 0}) {
+				t.Errorf("white.ToLabIn(white) = %v, want {100 0 0}", got)
+			}
+			if got := white.LStarIn(white); !almostEqual(got, 100) {
+				t.Errorf("white.LStarIn(white) = %v, want 100", got)
+			}
+		})
+	}
+
+	// D50 white is yellowish relative to D65
+	if got := IlluminantD50.ToLab(); got.B < 10 {
+		t.Errorf("IlluminantD50.ToLab() = %v, want positive b", got)
+	}
+	if got := ChromaticityFromXYZ(IlluminantD65); !almostEqual(got.X, ChromaticityD65.X) ||
+		!almostEqual(got.Y, ChromaticityD65.Y) {
+		t.Errorf("chromaticity of IlluminantD65 = %v, want %v", got, ChromaticityD65)
+	}
+}
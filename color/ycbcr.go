@@ -0,0 +1,103 @@
+package color
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/num"
+)
+
+// YCbCrStandard is the luma coefficients of a YCbCr matrix standard.
+type YCbCrStandard struct {
+	// Kr is the weight of red channel in luma
+	Kr float64
+	// Kb is the weight of blue channel in luma
+	Kb float64
+}
+
+var (
+	// BT601 is the ITU-R BT.601 (SD video, JPEG) matrix.
+	BT601 = YCbCrStandard{Kr: 0.299, Kb: 0.114}
+	// BT709 is the ITU-R BT.709 (HD video) matrix.
+	BT709 = YCbCrStandard{Kr: 0.2126, Kb: 0.0722}
+	// BT2020 is the ITU-R BT.2020 (UHD video) non-constant luminance matrix.
+	BT2020 = YCbCrStandard{Kr: 0.2627, Kb: 0.0593}
+)
+
+// YCbCr is a full range YCbCr color. Y is in range [0, 1] and Cb, Cr are in
+// range [-0.5, 0.5]. Standard is the matrix the color is encoded with, the
+// zero value means BT601.
+//
+// The matrix is applied on gamma encoded channels as video standards do. No
+// primaries conversion is done, the channels are treated as sRGB.
+type YCbCr struct {
+	Y, Cb, Cr float64
+	Standard  YCbCrStandard
+}
+
+var _ digitalColor = (*YCbCr)(nil)
+
+// NewYCbCr creates a YCbCr color encoded with the given matrix standard.
+func NewYCbCr(y, cb, cr float64, std YCbCrStandard) YCbCr {
+	return YCbCr{y, cb, cr, std}
+}
+
+// YCbCrFromARGB converts c to YCbCr using the given matrix standard.
+func YCbCrFromARGB(c ARGB, std YCbCrStandard) YCbCr {
+	r := float64(c.Red()) / 0xFF
+	g := float64(c.Green()) / 0xFF
+	b := float64(c.Blue()) / 0xFF
+
+	kg := 1 - std.Kr - std.Kb
+	y := std.Kr*r + kg*g + std.Kb*b
+	cb := 0.5 * (b - y) / (1 - std.Kb)
+	cr := 0.5 * (r - y) / (1 - std.Kr)
+	return YCbCr{y, cb, cr, std}
+}
+
+// ToARGB converts c to ARGB using its matrix standard. Out of range values are
+// clamped.
+func (c YCbCr) ToARGB() ARGB {
+	std := c.Standard
+	if std == (YCbCrStandard{}) {
+		std = BT601
+	}
+
+	kg := 1 - std.Kr - std.Kb
+	r := c.Y + 2*(1-std.Kr)*c.Cr
+	b := c.Y + 2*(1-std.Kb)*c.Cb
+	g := (c.Y - std.Kr*r - std.Kb*b) / kg
+
+	return ARGBFromRGB(
+		uint8(math.Round(num.Clamp(0, 1, r)*0xFF)),
+		uint8(math.Round(num.Clamp(0, 1, g)*0xFF)),
+		uint8(math.Round(num.Clamp(0, 1, b)*0xFF)),
+	)
+}
+
+// Values returns y, cb, cr values of YCbCr color
+func (c YCbCr) Values() (float64, float64, float64) {
+	return c.Y, c.Cb, c.Cr
+}
+
+// RGBA implements the color.Color interface.
+// It returns r, g, b, a values in the 0-65535 range.
+func (c YCbCr) RGBA() (uint32, uint32, uint32, uint32) {
+	return c.ToARGB().RGBA()
+}
+
+// ToXYZ returns XYZ color version for c.
+func (c YCbCr) ToXYZ() XYZ {
+	return c.ToARGB().ToXYZ()
+}
+
+func (c YCbCr) ToLab() Lab {
+	return c.ToARGB().ToLab()
+}
+
+func (c YCbCr) ToHct() Hct {
+	return c.ToARGB().ToHct()
+}
+
+func (c YCbCr) ToCam() *Cam16 {
+	return c.ToARGB().ToCam()
+}
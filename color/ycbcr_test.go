@@ -0,0 +1,54 @@
+package color
+
+import "testing"
+
+func TestYCbCr_FromARGB(t *testing.T) {
+	tests := []struct {
+		name string
+		argb ARGB
+		std  YCbCrStandard
+		want YCbCr
+	}{
+		{"White BT.601", 0xFFFFFFFF, BT601, YCbCr{1, 0, 0, BT601}},
+		{"Black BT.709", 0xFF000000, BT709, YCbCr{0, 0, 0, BT709}},
+		{"Red BT.601", 0xFFFF0000, BT601, YCbCr{0.299, -0.1687, 0.5, BT601}},
+		{"Red BT.709", 0xFFFF0000, BT709, YCbCr{0.2126, -0.1146, 0.5, BT709}},
+		{"Blue BT.2020", 0xFF0000FF, BT2020, YCbCr{0.0593, 0.5, -0.0402, BT2020}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := YCbCrFromARGB(tt.argb, tt.std)
+			if !sameValues(got, tt.want) || got.Standard != tt.want.Standard {
+				t.Errorf("YCbCrFromARGB(%s) = %v, want %v", tt.argb.HexRGB(), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestYCbCr_RoundTrip(t *testing.T) {
+	for _, std := range []YCbCrStandard{BT601, BT709, BT2020} {
+		testRoundTrip(t, func(c ARGB) YCbCr { return YCbCrFromARGB(c, std) })
+	}
+}
+
+func TestYCbCr_Hct(t *testing.T) {
+	for _, std := range []YCbCrStandard{BT601, BT709, BT2020} {
+		for _, tt := range ColorTestCases {
+			t.Run(tt.Name, func(t *testing.T) {
+				hct := YCbCrFromARGB(tt.ARGB, std).ToHct()
+				if got := YCbCrFromARGB(hct.ToARGB(), std).ToARGB(); got != tt.ARGB {
+					t.Errorf("YCbCr(%s) through HCT = %s, want %s", tt.ARGB.HexRGB(), got.HexRGB(), tt.ARGB.HexRGB())
+				}
+			})
+		}
+	}
+}
+
+func TestYCbCr_ZeroStandard(t *testing.T) {
+	c := YCbCrFromARGB(0xFF6750A4, BT601)
+	c.Standard = YCbCrStandard{}
+	if got := c.ToARGB(); got != 0xFF6750A4 {
+		t.Errorf("ToARGB() with zero standard = %s, want #6750A4", got.HexRGB())
+	}
+}
@@ -0,0 +1,78 @@
+package contrast
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/color"
+)
+
+// Constants of APCA 0.0.98G-4g.
+const (
+	apcaMainTRC = 2.4
+
+	apcaNormBG  = 0.56
+	apcaNormTXT = 0.57
+	apcaRevTXT  = 0.62
+	apcaRevBG   = 0.65
+
+	apcaBlkThrs = 0.022
+	apcaBlkClmp = 1.414
+
+	apcaScale     = 1.14
+	apcaLoOffset  = 0.027
+	apcaDeltaYMin = 0.0005
+	apcaLoClip    = 0.1
+)
+
+// apcaY returns the screen luminance of c used by APCA. APCA uses a simple
+// power curve instead of the piecewise sRGB transfer function.
+func apcaY(c color.ARGB) float64 {
+	channel := func(v uint8) float64 {
+		return math.Pow(float64(v)/0xFF, apcaMainTRC)
+	}
+	return 0.2126729*channel(c.Red()) + 0.7151522*channel(c.Green()) + 0.0721750*channel(c.Blue())
+}
+
+// softClampBlack soft clamps luminance near black to model flare.
+func softClampBlack(y float64) float64 {
+	if y > apcaBlkThrs {
+		return y
+	}
+	return y + math.Pow(apcaBlkThrs-y, apcaBlkClmp)
+}
+
+// APCA returns the lightness contrast Lc of text over background as defined by
+// the Accessible Perceptual Contrast Algorithm (APCA 0.0.98G-4g) proposed for
+// WCAG 3. Unlike WCAG 2 ratios, the result depends on polarity: it is positive
+// for dark text on light background and negative for light text on dark
+// background. Lc ranges roughly from -108 to 106, and 0 means not enough
+// contrast to measure. Translucent text is composited over background first.
+//
+// Common thresholds are |Lc| >= 75 for body text, 60 for large text and 45
+// for headlines and non-text elements.
+func APCA(text, background color.ARGB) float64 {
+	if text.Alpha() != 0xFF {
+		text = text.CompositeOver(background)
+	}
+	txtY := softClampBlack(apcaY(text))
+	bgY := softClampBlack(apcaY(background))
+	if math.Abs(bgY-txtY) < apcaDeltaYMin {
+		return 0
+	}
+
+	if bgY > txtY {
+		// Dark text on light background
+		sapc := (math.Pow(bgY, apcaNormBG) - math.Pow(txtY, apcaNormTXT)) * apcaScale
+		if sapc < apcaLoClip {
+			return 0
+		}
+		return (sapc - apcaLoOffset) * 100
+	}
+
+	// Light text on dark background
+	sapc := (math.Pow(bgY, apcaRevBG) - math.Pow(txtY, apcaRevTXT)) * apcaScale
+	if sapc > -apcaLoClip {
+		return 0
+	}
+	return (sapc + apcaLoOffset) * 100
+}
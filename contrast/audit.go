@@ -0,0 +1,122 @@
+package contrast
+
+import "github.com/Nadim147c/material/color"
+
+// Scheme is a color scheme with snake case role names, like
+// dynamic.DynamicScheme and schemes.Scheme.
+type Scheme interface {
+	ToARGBMap() map[string]color.ARGB
+}
+
+// auditPair is a foreground role drawn on a background role.
+type auditPair struct {
+	foreground, background string
+	size                   TextSize
+}
+
+// auditPairs are the role pairings checked by AuditScheme. Roles meant for
+// body text need AA for normal text. Accent roles drawn directly on surfaces
+// and fixed variant roles are meant for large text, icons and components, so
+// they need AA for large text.
+var auditPairs = []auditPair{
+	{"on_primary", "primary", NormalText},
+	{"on_primary_container", "primary_container", NormalText},
+	{"on_secondary", "secondary", NormalText},
+	{"on_secondary_container", "secondary_container", NormalText},
+	{"on_tertiary", "tertiary", NormalText},
+	{"on_tertiary_container", "tertiary_container", NormalText},
+	{"on_error", "error", NormalText},
+	{"on_error_container", "error_container", NormalText},
+	{"on_background", "background", NormalText},
+	{"on_surface", "surface", NormalText},
+	{"on_surface", "surface_dim", NormalText},
+	{"on_surface", "surface_bright", NormalText},
+	{"on_surface", "surface_container_lowest", NormalText},
+	{"on_surface", "surface_container_low", NormalText},
+	{"on_surface", "surface_container", NormalText},
+	{"on_surface", "surface_container_high", NormalText},
+	{"on_surface", "surface_container_highest", NormalText},
+	{"on_surface_variant", "surface", NormalText},
+	{"on_surface_variant", "surface_variant", NormalText},
+	{"inverse_on_surface", "inverse_surface", NormalText},
+	{"on_primary_fixed", "primary_fixed", NormalText},
+	{"on_primary_fixed", "primary_fixed_dim", NormalText},
+	{"on_secondary_fixed", "secondary_fixed", NormalText},
+	{"on_secondary_fixed", "secondary_fixed_dim", NormalText},
+	{"on_tertiary_fixed", "tertiary_fixed", NormalText},
+	{"on_tertiary_fixed", "tertiary_fixed_dim", NormalText},
+	{"primary", "surface", LargeText},
+	{"secondary", "surface", LargeText},
+	{"tertiary", "surface", LargeText},
+	{"error", "surface", LargeText},
+	{"inverse_primary", "inverse_surface", LargeText},
+	{"on_primary_fixed_variant", "primary_fixed", LargeText},
+	{"on_secondary_fixed_variant", "secondary_fixed", LargeText},
+	{"on_tertiary_fixed_variant", "tertiary_fixed", LargeText},
+}
+
+// AuditResult is the contrast of a foreground role drawn on a background role.
+type AuditResult struct {
+	Foreground string
+	Background string
+	Size       TextSize
+	Ratio      float64
+	MinRatio   float64
+}
+
+// Passed reports whether r meets its minimum ratio.
+func (r AuditResult) Passed() bool {
+	return r.Ratio >= r.MinRatio
+}
+
+// Conformance returns the highest WCAG 2 level r meets.
+func (r AuditResult) Conformance() Conformance {
+	return Classify(r.Ratio, r.Size)
+}
+
+// AuditReport is the result of AuditScheme.
+type AuditReport struct {
+	Results []AuditResult
+}
+
+// Failures returns the results that do not meet their minimum ratio.
+func (r AuditReport) Failures() []AuditResult {
+	var failures []AuditResult
+	for _, result := range r.Results {
+		if !result.Passed() {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// Passed reports whether every result of r meets its minimum ratio.
+func (r AuditReport) Passed() bool {
+	return len(r.Failures()) == 0
+}
+
+// AuditScheme checks every on-color and role pairing of s against WCAG 2 AA
+// and reports the contrast of each pairing. Pairings with roles missing from s
+// are skipped.
+func AuditScheme(s Scheme) AuditReport {
+	colors := s.ToARGBMap()
+	report := AuditReport{Results: make([]AuditResult, 0, len(auditPairs))}
+	for _, p := range auditPairs {
+		fg, ok := colors[p.foreground]
+		if !ok {
+			continue
+		}
+		bg, ok := colors[p.background]
+		if !ok {
+			continue
+		}
+		report.Results = append(report.Results, AuditResult{
+			Foreground: p.foreground,
+			Background: p.background,
+			Size:       p.size,
+			Ratio:      fg.ContrastRatio(bg),
+			MinRatio:   AA.MinRatio(p.size),
+		})
+	}
+	return report
+}
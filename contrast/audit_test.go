@@ -0,0 +1,36 @@
+package contrast_test
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/contrast"
+	"github.com/Nadim147c/material/dynamic"
+	"github.com/Nadim147c/material/schemes"
+)
+
+// TestAuditScheme_GeneratedSchemes is in an external test package because
+// schemes imports contrast through dynamic.
+func TestAuditScheme_GeneratedSchemes(t *testing.T) {
+	for _, seed := range []color.ARGB{0xFF6750A4, 0xFF0000FF, 0xFFFFD600, 0xFF00FF00} {
+		for _, version := range []dynamic.Version{dynamic.V2021, dynamic.V2025} {
+			for _, isDark := range []bool{false, true} {
+				scheme := schemes.NewTonalSpot(seed.ToHct(), isDark, 0, dynamic.Phone, version)
+				report := contrast.AuditScheme(scheme)
+				if len(report.Results) == 0 {
+					t.Fatalf("AuditScheme() checked no pairs")
+				}
+				for _, f := range report.Failures() {
+					t.Errorf("%s %d dark=%v: %s on %s = %.2f, want %v",
+						seed.HexRGB(), version, isDark, f.Foreground, f.Background, f.Ratio, f.MinRatio)
+				}
+			}
+		}
+
+		for _, scheme := range []schemes.Scheme{schemes.SchemeLightFromSeed(seed), schemes.SchemeDarkFromSeed(seed)} {
+			if report := contrast.AuditScheme(scheme); !report.Passed() {
+				t.Errorf("%s baseline failures = %v", seed.HexRGB(), report.Failures())
+			}
+		}
+	}
+}
@@ -1,3 +1,6 @@
+// Package contrast computes WCAG contrast ratios between tones and solves for
+// tones that reach a desired contrast ratio. Tones are L* values of CIELAB, so
+// contrast does not depend on hue or chroma.
 package contrast
 
 import (
@@ -3,12 +3,35 @@ package contrast
 import (
 	"math"
 	"testing"
+
+	"github.com/Nadim147c/material/color"
 )
 
 func almostEqual(a, b, tolerance float64) bool {
 	return math.Abs(a-b) <= tolerance
 }
 
+func TestRatioOfTones(t *testing.T) {
+	tests := []struct {
+		toneA, toneB float64
+		want         float64
+	}{
+		{0, 100, 21},
+		{100, 0, 21},
+		{50, 50, 1},
+		{0, 0, 1},
+		{100, 50, 4.4836},
+		{40, 100, 6.4612},
+		{90, 10, 13.2718},
+	}
+
+	for _, tt := range tests {
+		if got := RatioOfTones(tt.toneA, tt.toneB); !almostEqual(got, tt.want, 0.001) {
+			t.Errorf("RatioOfTones(%v, %v) = %v, want %v", tt.toneA, tt.toneB, got, tt.want)
+		}
+	}
+}
+
 func TestRatioOfTones_OutOfBoundsInput(t *testing.T) {
 	got := RatioOfTones(-10.0, 110.0)
 	want := 21.0
@@ -80,3 +103,258 @@ func TestDarkerUnsafe_ReturnsMinTone(t *testing.T) {
 		t.Errorf("DarkerUnsafe(0.0, 2.0) = %v, want %v", got, want)
 	}
 }
+
+func TestLighterDarker_ReachRatio(t *testing.T) {
+	for _, ratio := range []float64{1.5, 3, 4.5, 7} {
+		for tone := 0.0; tone <= 100; tone += 10 {
+			if got := Lighter(tone, ratio); got >= 0 {
+				if got < tone || RatioOfTones(tone, got) < ratio {
+					t.Errorf("Lighter(%v, %v) = %v with ratio %v", tone, ratio, got, RatioOfTones(tone, got))
+				}
+			} else if RatioOfTones(tone, 100) >= ratio+0.04 {
+				t.Errorf("Lighter(%v, %v) = -1, but tone 100 has ratio %v", tone, ratio, RatioOfTones(tone, 100))
+			}
+
+			if got := Darker(tone, ratio); got >= 0 {
+				if got > tone || RatioOfTones(tone, got) < ratio {
+					t.Errorf("Darker(%v, %v) = %v with ratio %v", tone, ratio, got, RatioOfTones(tone, got))
+				}
+			} else if RatioOfTones(tone, 0) >= ratio+0.04 {
+				t.Errorf("Darker(%v, %v) = -1, but tone 0 has ratio %v", tone, ratio, RatioOfTones(tone, 0))
+			}
+
+			if got := LighterUnsafe(tone, ratio); got < tone || got > 100 {
+				t.Errorf("LighterUnsafe(%v, %v) = %v, want in [%v, 100]", tone, ratio, got, tone)
+			}
+			if got := DarkerUnsafe(tone, ratio); got > tone || got < 0 {
+				t.Errorf("DarkerUnsafe(%v, %v) = %v, want in [0, %v]", tone, ratio, got, tone)
+			}
+		}
+	}
+}
+
+func TestAPCA(t *testing.T) {
+	tests := []struct {
+		text, background color.ARGB
+		want             float64
+	}{
+		{0xFF000000, 0xFFFFFFFF, 106.04},
+		{0xFFFFFFFF, 0xFF000000, -107.88},
+		{0xFF888888, 0xFFFFFFFF, 63.06},
+		{0xFFFFFFFF, 0xFF888888, -68.54},
+		{0xFF000000, 0xFFAAAAAA, 58.15},
+		{0xFF112233, 0xFFDDEEFF, 91.66},
+		{0xFFDDEEFF, 0xFF112233, -93.07},
+		{0xFF6750A4, 0xFF6750A4, 0},
+		{0x00000000, 0xFFFFFFFF, 0},
+	}
+
+	for _, tt := range tests {
+		if got := APCA(tt.text, tt.background); !almostEqual(got, tt.want, 0.01) {
+			t.Errorf("APCA(%s, %s) = %v, want %v", tt.text.HexRGB(), tt.background.HexRGB(), got, tt.want)
+		}
+	}
+}
+
+func TestBestForeground(t *testing.T) {
+	tests := []struct {
+		bg         color.ARGB
+		candidates []color.ARGB
+		want       color.ARGB
+	}{
+		{0xFFFFFFFF, nil, 0xFF000000},
+		{0xFF000000, nil, 0xFFFFFFFF},
+		{0xFF6750A4, nil, 0xFFFFFFFF},
+		{0xFFFFD600, nil, 0xFF000000},
+		{0xFFFFFFFF, []color.ARGB{0xFFEEEEEE, 0xFF6750A4, 0xFF888888}, 0xFF6750A4},
+		{0xFF000000, []color.ARGB{0xFF6750A4, 0xFF6750A4}, 0xFF6750A4},
+	}
+
+	for _, tt := range tests {
+		if got := BestForeground(tt.bg, tt.candidates...); got != tt.want {
+			t.Errorf("BestForeground(%s, %v) = %s, want %s", tt.bg.HexRGB(), tt.candidates, got.HexRGB(), tt.want.HexRGB())
+		}
+	}
+}
+
+func TestBestForegroundTone(t *testing.T) {
+	tests := []struct {
+		bg         float64
+		candidates []float64
+		want       float64
+	}{
+		{90, nil, 0},
+		{30, nil, 100},
+		{50, nil, 0},
+		{40, []float64{20, 80, 95}, 95},
+		{60, []float64{10, 30, 100}, 10},
+	}
+
+	for _, tt := range tests {
+		if got := BestForegroundTone(tt.bg, tt.candidates...); got != tt.want {
+			t.Errorf("BestForegroundTone(%v, %v) = %v, want %v", tt.bg, tt.candidates, got, tt.want)
+		}
+	}
+}
+
+func TestEnsureContrast(t *testing.T) {
+	backgrounds := []color.ARGB{0xFFFFFFFF, 0xFF000000, 0xFF1C1B1F, 0xFFF3EDF7, 0xFF6750A4, 0xFF888888}
+	foregrounds := []color.ARGB{0xFF6750A4, 0xFFFF0000, 0xFF00A0FF, 0xFFFFD600, 0xFF888888, 0xFF1C1B1F}
+
+	for _, ratio := range []float64{3, 4.5, 7} {
+		for _, bg := range backgrounds {
+			for _, fg := range foregrounds {
+				got := EnsureContrast(fg, bg, ratio)
+				if fg.ContrastRatio(bg) >= ratio {
+					if got != fg {
+						t.Errorf("EnsureContrast(%s, %s, %v) = %s, want unchanged",
+							fg.HexRGB(), bg.HexRGB(), ratio, got.HexRGB())
+					}
+					continue
+				}
+
+				reachable := max(color.ARGB(0xFF000000).ContrastRatio(bg), color.ARGB(0xFFFFFFFF).ContrastRatio(bg))
+				if reachable >= ratio+0.1 && got.ContrastRatio(bg) < ratio {
+					t.Errorf("EnsureContrast(%s, %s, %v) = %s with ratio %.2f",
+						fg.HexRGB(), bg.HexRGB(), ratio, got.HexRGB(), got.ContrastRatio(bg))
+				}
+
+				want, have := fg.ToHct(), got.ToHct()
+				if want.Chroma > 10 && have.Chroma > 10 && math.Abs(want.Hue-have.Hue) > 2 {
+					t.Errorf("EnsureContrast(%s, %s, %v) hue = %.1f, want %.1f",
+						fg.HexRGB(), bg.HexRGB(), ratio, have.Hue, want.Hue)
+				}
+			}
+		}
+	}
+}
+
+func TestEnsureContrastTone(t *testing.T) {
+	tests := []struct {
+		tone, bg, ratio float64
+		want            float64
+	}{
+		{40, 100, 4.5, 40},
+		{60, 100, 4.5, 49.5},
+		{70, 40, 4.5, 86.47},
+		{50, 60, 3, 29.12},
+		{50, 50, 21, 0},
+	}
+
+	for _, tt := range tests {
+		if got := EnsureContrastTone(tt.tone, tt.bg, tt.ratio); !almostEqual(got, tt.want, 0.1) {
+			t.Errorf("EnsureContrastTone(%v, %v, %v) = %v, want %v", tt.tone, tt.bg, tt.ratio, got, tt.want)
+		}
+	}
+}
+
+func TestAccessiblePairs(t *testing.T) {
+	for _, seed := range []color.ARGB{0xFF6750A4, 0xFFFFD600, 0xFF00FF00, 0xFF000000, 0xFFFFFFFF} {
+		pairs := AccessiblePairs(seed)
+		if len(pairs) != 8 {
+			t.Fatalf("len(AccessiblePairs(%s)) = %d, want 8", seed.HexRGB(), len(pairs))
+		}
+
+		for _, p := range pairs {
+			ratio := p.Foreground.ContrastRatio(p.Background)
+			if want := p.Level.MinRatio(p.Size); ratio < want {
+				t.Errorf("%s %s/%s dark=%v: ratio %.2f, want %v",
+					seed.HexRGB(), p.Level, p.Size, p.Dark, ratio, want)
+			}
+			if bgTone := p.Background.LStar(); p.Dark != (bgTone < 50) {
+				t.Errorf("%s dark=%v: background tone %.1f", seed.HexRGB(), p.Dark, bgTone)
+			}
+		}
+	}
+
+	// Seed is kept where it is already accessible
+	seed := color.ARGB(0xFF6750A4)
+	if got := AccessiblePairs(seed)[0].Foreground; got != seed {
+		t.Errorf("light AA foreground = %s, want %s", got.HexRGB(), seed.HexRGB())
+	}
+}
+
+// testScheme is a Scheme backed by a map.
+type testScheme map[string]color.ARGB
+
+func (s testScheme) ToARGBMap() map[string]color.ARGB {
+	return s
+}
+
+func TestAuditScheme(t *testing.T) {
+	scheme := testScheme{
+		"primary":    0xFF6750A4,
+		"on_primary": 0xFFFFFFFF,
+		"surface":    0xFFFEF7FF,
+		"on_surface": 0xFFCCCCCC,
+		"error":      0xFFB3261E,
+	}
+
+	report := AuditScheme(scheme)
+	if len(report.Results) != 4 {
+		t.Errorf("len(Results) = %d, want 4: %v", len(report.Results), report.Results)
+	}
+	if report.Passed() {
+		t.Errorf("Passed() = true, want false")
+	}
+
+	failures := report.Failures()
+	if len(failures) != 1 {
+		t.Fatalf("Failures() = %v, want on_surface on surface", failures)
+	}
+	got := failures[0]
+	if got.Foreground != "on_surface" || got.Background != "surface" || got.MinRatio != 4.5 || got.Size != NormalText {
+		t.Errorf("Failures()[0] = %+v, want on_surface on surface", got)
+	}
+	if !almostEqual(got.Ratio, 1.53, 0.01) {
+		t.Errorf("Failures()[0].Ratio = %v, want 1.53", got.Ratio)
+	}
+
+	if !AuditScheme(testScheme{}).Passed() {
+		t.Errorf("AuditScheme(empty).Passed() = false, want true")
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		ratio float64
+		size  TextSize
+		want  Conformance
+	}{
+		{21, NormalText, PassAAA},
+		{7, NormalText, PassAAA},
+		{6.99, NormalText, PassAA},
+		{4.5, NormalText, PassAA},
+		{4.49, NormalText, Fail},
+		{4.5, LargeText, PassAAA},
+		{3, LargeText, PassAA},
+		{2.99, LargeText, Fail},
+		{1, LargeText, Fail},
+	}
+
+	for _, tt := range tests {
+		if got := Classify(tt.ratio, tt.size); got != tt.want {
+			t.Errorf("Classify(%v, %s) = %s, want %s", tt.ratio, tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestCheckText(t *testing.T) {
+	results := CheckText(5, NormalText)
+	if len(results) != 2 {
+		t.Fatalf("len(CheckText()) = %d, want 2", len(results))
+	}
+	if r := results[0]; r.Criterion != ContrastMinimum || !r.Pass || r.MinRatio != 4.5 {
+		t.Errorf("CheckText()[0] = %+v, want passing %s", r, ContrastMinimum)
+	}
+	if r := results[1]; r.Criterion != ContrastEnhanced || r.Pass || r.MinRatio != 7 {
+		t.Errorf("CheckText()[1] = %+v, want failing %s", r, ContrastEnhanced)
+	}
+
+	if r := CheckNonText(2.9); r.Criterion != NonTextContrast || r.Pass || r.MinRatio != 3 {
+		t.Errorf("CheckNonText(2.9) = %+v, want failing %s", r, NonTextContrast)
+	}
+	if got := NonTextContrast.String(); got != "1.4.11 Non-text Contrast" {
+		t.Errorf("NonTextContrast.String() = %q", got)
+	}
+}
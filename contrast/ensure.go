@@ -0,0 +1,46 @@
+package contrast
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/color"
+)
+
+// EnsureContrast returns fg with its HCT tone moved just enough to reach ratio
+// against bg, keeping hue and chroma so brand colors stay recognizable. fg is
+// returned unchanged if it already meets ratio. The tone moves towards
+// whichever side of bg is closer to the tone of fg. If ratio can not be
+// reached on either side, the tone with the most contrast, 0 or 100, is used.
+// Chroma may still be reduced if the new tone can not hold it in sRGB. Alpha
+// is ignored and the result is opaque.
+func EnsureContrast(fg, bg color.ARGB, ratio float64) color.ARGB {
+	fg |= 0xFF000000
+	if fg.ContrastRatio(bg) >= ratio {
+		return fg
+	}
+
+	hct := fg.ToHct()
+	return color.NewHct(hct.Hue, hct.Chroma, EnsureContrastTone(hct.Tone, bg.LStar(), ratio)).ToARGB()
+}
+
+// EnsureContrastTone is like EnsureContrast but for tones. It returns the tone
+// closest to tone that has at least ratio against bgTone.
+func EnsureContrastTone(tone, bgTone, ratio float64) float64 {
+	if RatioOfTones(tone, bgTone) >= ratio {
+		return tone
+	}
+
+	lighter, darker := Lighter(bgTone, ratio), Darker(bgTone, ratio)
+	switch {
+	case lighter < 0 && darker < 0:
+		return BestForegroundTone(bgTone)
+	case lighter < 0:
+		return darker
+	case darker < 0:
+		return lighter
+	case math.Abs(lighter-tone) <= math.Abs(darker-tone):
+		return lighter
+	default:
+		return darker
+	}
+}
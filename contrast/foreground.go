@@ -0,0 +1,36 @@
+package contrast
+
+import "github.com/Nadim147c/material/color"
+
+// BestForeground returns the candidate with the highest WCAG contrast ratio
+// against bg, see color.ARGB.ContrastRatio. If no candidate is given, black
+// and white are compared. Ties are resolved in favor of the earlier candidate.
+func BestForeground(bg color.ARGB, candidates ...color.ARGB) color.ARGB {
+	if len(candidates) == 0 {
+		candidates = []color.ARGB{0xFF000000, 0xFFFFFFFF}
+	}
+
+	best, bestRatio := candidates[0], candidates[0].ContrastRatio(bg)
+	for _, c := range candidates[1:] {
+		if ratio := c.ContrastRatio(bg); ratio > bestRatio {
+			best, bestRatio = c, ratio
+		}
+	}
+	return best
+}
+
+// BestForegroundTone is like BestForeground but for tones. If no candidate is
+// given, tones 0 and 100 are compared.
+func BestForegroundTone(bgTone float64, candidates ...float64) float64 {
+	if len(candidates) == 0 {
+		candidates = []float64{0, 100}
+	}
+
+	best, bestRatio := candidates[0], RatioOfTones(candidates[0], bgTone)
+	for _, tone := range candidates[1:] {
+		if ratio := RatioOfTones(tone, bgTone); ratio > bestRatio {
+			best, bestRatio = tone, ratio
+		}
+	}
+	return best
+}
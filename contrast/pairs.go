@@ -0,0 +1,52 @@
+package contrast
+
+import "github.com/Nadim147c/material/color"
+
+// Pair is a background and foreground color that meet a WCAG 2 text contrast
+// requirement.
+type Pair struct {
+	Level      Level
+	Size       TextSize
+	Dark       bool
+	Background color.ARGB
+	Foreground color.ARGB
+}
+
+// Tones and chroma limit of the backgrounds of AccessiblePairs.
+const (
+	pairLightBackgroundTone = 95
+	pairDarkBackgroundTone  = 10
+	pairBackgroundChroma    = 16
+)
+
+// AccessiblePairs returns background and foreground pairs built from seed for
+// every Level and TextSize, first in a light and then in a dark context.
+// Backgrounds are tinted with the hue of seed. Foregrounds are seed with its
+// tone adjusted by EnsureContrast, so seed itself is used where it is
+// accessible.
+func AccessiblePairs(seed color.ARGB) []Pair {
+	hct := seed.ToHct()
+	chroma := min(hct.Chroma, pairBackgroundChroma)
+
+	pairs := make([]Pair, 0, 8)
+	for _, dark := range []bool{false, true} {
+		tone := float64(pairLightBackgroundTone)
+		if dark {
+			tone = pairDarkBackgroundTone
+		}
+		bg := color.NewHct(hct.Hue, chroma, tone).ToARGB()
+
+		for _, level := range []Level{AA, AAA} {
+			for _, size := range []TextSize{NormalText, LargeText} {
+				pairs = append(pairs, Pair{
+					Level:      level,
+					Size:       size,
+					Dark:       dark,
+					Background: bg,
+					Foreground: EnsureContrast(seed, bg, level.MinRatio(size)),
+				})
+			}
+		}
+	}
+	return pairs
+}
@@ -0,0 +1,157 @@
+package contrast
+
+// TextSize is the size class of text in WCAG 2. Large text is at least 18pt,
+// or 14pt bold.
+type TextSize int
+
+const (
+	NormalText TextSize = iota
+	LargeText
+)
+
+// String returns the name of s.
+func (s TextSize) String() string {
+	if s == LargeText {
+		return "large"
+	}
+	return "normal"
+}
+
+// Level is a WCAG 2 conformance level of text contrast.
+type Level int
+
+const (
+	// AA requires 4.5:1 for normal text and 3:1 for large text
+	AA Level = iota
+	// AAA requires 7:1 for normal text and 4.5:1 for large text
+	AAA
+)
+
+// String returns the name of l.
+func (l Level) String() string {
+	if l == AAA {
+		return "AAA"
+	}
+	return "AA"
+}
+
+// MinRatio returns the minimum contrast ratio l requires for text of size.
+func (l Level) MinRatio(size TextSize) float64 {
+	switch {
+	case l == AAA && size == NormalText:
+		return 7
+	case l == AA && size == LargeText:
+		return 3
+	default:
+		return 4.5
+	}
+}
+
+// Conformance is the highest WCAG 2 level a contrast ratio meets for text.
+type Conformance int
+
+const (
+	// Fail means the ratio does not meet AA
+	Fail Conformance = iota
+	// PassAA means the ratio meets AA but not AAA
+	PassAA
+	// PassAAA means the ratio meets AAA
+	PassAAA
+)
+
+// String returns the name of c.
+func (c Conformance) String() string {
+	switch c {
+	case PassAA:
+		return "AA"
+	case PassAAA:
+		return "AAA"
+	default:
+		return "fail"
+	}
+}
+
+// Classify returns the highest level ratio meets for text of size.
+func Classify(ratio float64, size TextSize) Conformance {
+	switch {
+	case ratio >= AAA.MinRatio(size):
+		return PassAAA
+	case ratio >= AA.MinRatio(size):
+		return PassAA
+	default:
+		return Fail
+	}
+}
+
+// Criterion is a WCAG 2 contrast success criterion.
+type Criterion int
+
+const (
+	// ContrastMinimum is success criterion 1.4.3, level AA
+	ContrastMinimum Criterion = iota
+	// ContrastEnhanced is success criterion 1.4.6, level AAA
+	ContrastEnhanced
+	// NonTextContrast is success criterion 1.4.11, level AA. It requires 3:1
+	// for user interface components and graphical objects.
+	NonTextContrast
+)
+
+// String returns the number and name of c, e.g. "1.4.3 Contrast (Minimum)".
+func (c Criterion) String() string {
+	switch c {
+	case ContrastEnhanced:
+		return "1.4.6 Contrast (Enhanced)"
+	case NonTextContrast:
+		return "1.4.11 Non-text Contrast"
+	default:
+		return "1.4.3 Contrast (Minimum)"
+	}
+}
+
+// MinRatio returns the minimum contrast ratio c requires for text of size.
+// Size is ignored for NonTextContrast.
+func (c Criterion) MinRatio(size TextSize) float64 {
+	switch c {
+	case ContrastEnhanced:
+		return AAA.MinRatio(size)
+	case NonTextContrast:
+		return 3
+	default:
+		return AA.MinRatio(size)
+	}
+}
+
+// Result is the outcome of checking a contrast ratio against a Criterion.
+type Result struct {
+	Criterion Criterion
+	Size      TextSize
+	Ratio     float64
+	MinRatio  float64
+	Pass      bool
+}
+
+// CheckCriterion checks ratio against c for text of size.
+func CheckCriterion(c Criterion, ratio float64, size TextSize) Result {
+	minRatio := c.MinRatio(size)
+	return Result{
+		Criterion: c,
+		Size:      size,
+		Ratio:     ratio,
+		MinRatio:  minRatio,
+		Pass:      ratio >= minRatio,
+	}
+}
+
+// CheckText checks ratio against ContrastMinimum and ContrastEnhanced for text
+// of size.
+func CheckText(ratio float64, size TextSize) []Result {
+	return []Result{
+		CheckCriterion(ContrastMinimum, ratio, size),
+		CheckCriterion(ContrastEnhanced, ratio, size),
+	}
+}
+
+// CheckNonText checks ratio against NonTextContrast.
+func CheckNonText(ratio float64) Result {
+	return CheckCriterion(NonTextContrast, ratio, NormalText)
+}
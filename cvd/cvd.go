@@ -0,0 +1,82 @@
+// Package cvd simulates color vision deficiencies (CVD) and corrects colors so
+// they stay distinguishable for people with them.
+package cvd
+
+import (
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/num"
+)
+
+// Deficiency is a type of color vision deficiency.
+type Deficiency int
+
+const (
+	// Protanopia is the lack of long wavelength (red) cones.
+	Protanopia Deficiency = iota
+	// Deuteranopia is the lack of medium wavelength (green) cones.
+	Deuteranopia
+	// Tritanopia is the lack of short wavelength (blue) cones.
+	Tritanopia
+	// Achromatopsia is the lack of color vision.
+	Achromatopsia
+)
+
+// String returns the name of d.
+func (d Deficiency) String() string {
+	switch d {
+	case Protanopia:
+		return "protanopia"
+	case Deuteranopia:
+		return "deuteranopia"
+	case Tritanopia:
+		return "tritanopia"
+	case Achromatopsia:
+		return "achromatopsia"
+	default:
+		return "unknown"
+	}
+}
+
+// Simulation matrices in linear sRGB for full severity. Protanopia,
+// deuteranopia and tritanopia use the model of Machado, Oliveira and
+// Fernandes (2009).
+var simulationMatrices = map[Deficiency]num.Matrix3{
+	Protanopia: num.NewMatrix3(
+		0.152286, 1.052583, -0.204868,
+		0.114503, 0.786281, 0.099216,
+		-0.003882, -0.048116, 1.051998,
+	),
+	Deuteranopia: num.NewMatrix3(
+		0.367322, 0.860646, -0.227968,
+		0.280085, 0.672501, 0.047413,
+		-0.011820, 0.042940, 0.968881,
+	),
+	Tritanopia: num.NewMatrix3(
+		1.255528, -0.076749, -0.178779,
+		-0.078411, 0.930809, 0.147602,
+		0.004733, 0.691367, 0.303900,
+	),
+	Achromatopsia: num.NewMatrix3(
+		0.2126, 0.7152, 0.0722,
+		0.2126, 0.7152, 0.0722,
+		0.2126, 0.7152, 0.0722,
+	),
+}
+
+// simulateLinear returns c as seen with d at severity in linear sRGB.
+func simulateLinear(c color.LinRGB, d Deficiency, severity float64) color.LinRGB {
+	m, ok := simulationMatrices[d]
+	if !ok {
+		return c
+	}
+	r, g, b := m.MultiplyXYZ(c.Values()).Values()
+	return c.Mix(color.NewLinRGB(r, g, b), num.Clamp(0, 1, severity))
+}
+
+// Simulate returns c as seen by a person with deficiency d. Severity is in
+// range [0, 1] where 0 is normal vision and 1 is the complete lack of the
+// affected cones. Alpha is kept.
+func Simulate(c color.ARGB, d Deficiency, severity float64) color.ARGB {
+	sim := simulateLinear(color.LinRGBFromARGB(c), d, severity).ToARGB()
+	return sim&0x00FFFFFF | c&0xFF000000
+}
@@ -0,0 +1,99 @@
+package cvd
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+)
+
+func TestSimulate(t *testing.T) {
+	tests := []struct {
+		name string
+		c    color.ARGB
+		d    Deficiency
+	}{
+		{"protanopia", 0xFFFF0000, Protanopia},
+		{"deuteranopia", 0xFF00FF00, Deuteranopia},
+		{"tritanopia", 0xFF0000FF, Tritanopia},
+		{"achromatopsia", 0xFF6750A4, Achromatopsia},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Simulate(tt.c, tt.d, 0); got != tt.c {
+				t.Errorf("Simulate(severity 0) = %s, want %s", got.HexRGB(), tt.c.HexRGB())
+			}
+			if got := Simulate(tt.c, tt.d, 1); got == tt.c {
+				t.Errorf("Simulate(severity 1) = %s, want changed", got.HexRGB())
+			}
+			// Neutral colors look the same for everyone
+			for _, gray := range []color.ARGB{0xFF000000, 0xFF808080, 0xFFFFFFFF} {
+				if got := Simulate(gray, tt.d, 1); distance(got, gray) > 1 {
+					t.Errorf("Simulate(%s) = %s, want unchanged", gray.HexRGB(), got.HexRGB())
+				}
+			}
+		})
+	}
+
+	if got := Simulate(0x80FF0000, Achromatopsia, 1); got.Alpha() != 0x80 || got.Red() != got.Green() || got.Green() != got.Blue() {
+		t.Errorf("Simulate(achromatopsia) = %s, want translucent gray", got.HexARGB())
+	}
+}
+
+func TestDaltonizePalette(t *testing.T) {
+	palette := []color.ARGB{0xFFD32F2F, 0xFF388E3C, 0xFF1976D2, 0xFF000000}
+
+	for _, d := range []Deficiency{Protanopia, Deuteranopia} {
+		t.Run(d.String(), func(t *testing.T) {
+			before := distance(Simulate(palette[0], d, 1), Simulate(palette[1], d, 1))
+			got := DaltonizePalette(palette, d, 1)
+			after := distance(Simulate(got[0], d, 1), Simulate(got[1], d, 1))
+			if after <= before {
+				t.Errorf("simulated distance of red and green = %.2f, want more than %.2f", after, before)
+			}
+
+			for i, c := range got {
+				if tone, want := c.ToHct().Tone, palette[i].ToHct().Tone; tone-want > 1 || want-tone > 1 {
+					t.Errorf("color %d tone = %.1f, want %.1f", i, tone, want)
+				}
+			}
+			if got[3] != palette[3] {
+				t.Errorf("black = %s, want unchanged", got[3].HexRGB())
+			}
+		})
+	}
+
+	if got := Daltonize(0xFF6750A4, Achromatopsia, 1); got != 0xFF6750A4 {
+		t.Errorf("Daltonize(achromatopsia) = %s, want unchanged", got.HexRGB())
+	}
+}
+
+func TestValidate(t *testing.T) {
+	// Red and green collide for red-green deficiencies only
+	palette := []color.ARGB{0xFFD32F2F, 0xFF388E3C, 0xFFFFFFFF}
+	collisions := Validate(palette, 0)
+	if len(collisions) == 0 {
+		t.Fatal("Validate() = no collisions, want red and green")
+	}
+	for _, c := range collisions {
+		if c.I != 0 || c.J != 1 || c.Deficiency == Tritanopia || c.Distance >= DefaultMinDistance {
+			t.Errorf("Validate() collision = %+v, want red and green", c)
+		}
+	}
+
+	if got := Validate(palette, 0, Tritanopia); len(got) != 0 {
+		t.Errorf("Validate(tritanopia) = %v, want none", got)
+	}
+	if got := Validate([]color.ARGB{0xFF388E3C, 0xFF1976D2}, 0, Tritanopia); len(got) != 1 {
+		t.Errorf("Validate(green, blue) = %v, want a tritanopia collision", got)
+	}
+	if got := Validate(palette, 0, Achromatopsia); len(got) == 0 {
+		t.Errorf("Validate(achromatopsia) = none, want collisions")
+	}
+
+	// Tones far apart stay distinguishable for everyone
+	ramp := []color.ARGB{0xFF000000, 0xFF777777, 0xFFFFFFFF}
+	if got := Validate(ramp, 0, Protanopia, Deuteranopia, Tritanopia, Achromatopsia); len(got) != 0 {
+		t.Errorf("Validate(gray ramp) = %v, want none", got)
+	}
+}
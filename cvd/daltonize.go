@@ -0,0 +1,84 @@
+package cvd
+
+import (
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/num"
+)
+
+// confusableDistance is the CAM16-UCS distance below which two simulated
+// colors are considered confusable.
+const confusableDistance = 10.0
+
+// Matrices that move the information lost by a deficiency into channels that
+// are still visible, from Fidaner, Lin and Ozguven (2005).
+var (
+	redGreenShift = num.NewMatrix3(
+		0, 0, 0,
+		0.7, 1, 0,
+		0.7, 0, 1,
+	)
+	blueShift = num.NewMatrix3(
+		1, 0, 0.7,
+		0, 1, 0.7,
+		0, 0, 0,
+	)
+)
+
+// Daltonize returns c corrected for deficiency d at severity. The difference
+// between c and its simulation is the information lost by d, which is shifted
+// into channels that remain visible. Achromatopsia can not be corrected this
+// way and c is returned as is. Alpha is kept.
+func Daltonize(c color.ARGB, d Deficiency, severity float64) color.ARGB {
+	shift := redGreenShift
+	switch d {
+	case Protanopia, Deuteranopia:
+	case Tritanopia:
+		shift = blueShift
+	default:
+		return c
+	}
+
+	lin := color.LinRGBFromARGB(c)
+	lost := lin.Add(simulateLinear(lin, d, severity).Scale(-1))
+	r, g, b := shift.MultiplyXYZ(lost.Values()).Values()
+	corrected := lin.Add(color.NewLinRGB(r, g, b)).ToARGB()
+	return corrected&0x00FFFFFF | c&0xFF000000
+}
+
+// distance returns the CAM16-UCS distance between a and b.
+func distance(a, b color.ARGB) float64 {
+	return a.ToCam().Distance(b.ToCam())
+}
+
+// DaltonizePalette returns a copy of colors where colors that are confusable
+// with another color under d are corrected with Daltonize. Only colors that
+// collide are changed, and they keep their HCT tone so the contrast between
+// roles, and so the structure of a scheme, is preserved.
+func DaltonizePalette(colors []color.ARGB, d Deficiency, severity float64) []color.ARGB {
+	simulated := make([]color.ARGB, len(colors))
+	for i, c := range colors {
+		simulated[i] = Simulate(c, d, severity)
+	}
+
+	confusable := make([]bool, len(colors))
+	for i := range colors {
+		for j := i + 1; j < len(colors); j++ {
+			if distance(colors[i], colors[j]) >= confusableDistance &&
+				distance(simulated[i], simulated[j]) < confusableDistance {
+				confusable[i], confusable[j] = true, true
+			}
+		}
+	}
+
+	result := make([]color.ARGB, len(colors))
+	for i, c := range colors {
+		if !confusable[i] {
+			result[i] = c
+			continue
+		}
+		hct := Daltonize(c, d, severity).ToHct()
+		corrected := color.NewHct(hct.Hue, hct.Chroma, c.ToHct().Tone).ToARGB()
+		result[i] = corrected&0x00FFFFFF | c&0xFF000000
+	}
+	return result
+}
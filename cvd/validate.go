@@ -0,0 +1,48 @@
+package cvd
+
+import "github.com/Nadim147c/material/color"
+
+// DefaultMinDistance is the CAM16-UCS distance categorical colors should keep
+// to stay distinguishable.
+const DefaultMinDistance = confusableDistance
+
+// Collision is a pair of colors that become hard to tell apart under a
+// deficiency.
+type Collision struct {
+	// I and J are indices of the colors in the validated palette, I < J
+	I, J       int
+	Deficiency Deficiency
+	// Distance is the CAM16-UCS distance of the simulated colors
+	Distance float64
+}
+
+// Validate checks whether every pair of colors stays at least minDistance
+// apart in CAM16-UCS when simulated with each deficiency at full severity. It
+// returns every pair that collides, ordered by deficiency and index. If
+// deficiencies is empty, Protanopia, Deuteranopia and Tritanopia are checked.
+// If minDistance is not positive, DefaultMinDistance is used. Pairs that are
+// already too close with normal vision are reported too.
+func Validate(colors []color.ARGB, minDistance float64, deficiencies ...Deficiency) []Collision {
+	if minDistance <= 0 {
+		minDistance = DefaultMinDistance
+	}
+	if len(deficiencies) == 0 {
+		deficiencies = []Deficiency{Protanopia, Deuteranopia, Tritanopia}
+	}
+
+	var collisions []Collision
+	simulated := make([]color.ARGB, len(colors))
+	for _, d := range deficiencies {
+		for i, c := range colors {
+			simulated[i] = Simulate(c, d, 1)
+		}
+		for i := range simulated {
+			for j := i + 1; j < len(simulated); j++ {
+				if dist := distance(simulated[i], simulated[j]); dist < minDistance {
+					collisions = append(collisions, Collision{I: i, J: j, Deficiency: d, Distance: dist})
+				}
+			}
+		}
+	}
+	return collisions
+}
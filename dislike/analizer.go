@@ -1,3 +1,7 @@
+// Package dislike detects and fixes colors that are universally disliked, such
+// as dark yellow-greens that remind of bile and mold. Fidelity schemes use it
+// when picking a tertiary color, and it can be used to filter colors extracted
+// from images.
 package dislike
 
 import "github.com/Nadim147c/material/color"
@@ -1,6 +1,7 @@
 package dislike
 
 import (
+	"math"
 	"testing"
 
 	"github.com/Nadim147c/material/color"
@@ -81,4 +82,15 @@ func TestDislikeAnalyzer(t *testing.T) {
 			t.Error("Expected fixIfDisliked to not modify a color that isn't disliked")
 		}
 	})
+
+	t.Run("fixes disliked colors to tone 70", func(t *testing.T) {
+		hct := color.ARGB(0xff4c4308).ToHct()
+		fixed := FixIfDisliked(hct)
+		if math.Abs(fixed.Tone-70) > 0.5 {
+			t.Errorf("FixIfDisliked(%v).Tone = %v, want 70", hct, fixed.Tone)
+		}
+		if math.Abs(fixed.Hue-hct.Hue) > 2 {
+			t.Errorf("FixIfDisliked(%v).Hue = %v, want %v", hct, fixed.Hue, hct.Hue)
+		}
+	})
 }
@@ -53,8 +53,8 @@ func (d ColorCalculationDelegateImpl2021) GetTone(scheme DynamicScheme, dc Dynam
 		if scheme.IsDark {
 			expansionDir = 1.0
 		}
-		nTone := nearer.Tone(scheme)
-		fTone := farther.Tone(scheme)
+		nTone := nearer.InitialTone(scheme)
+		fTone := farther.InitialTone(scheme)
 
 		if dc.Background != nil && nearer.ContrastCurve != nil && farther.ContrastCurve != nil {
 			bg := dc.Background(scheme)
@@ -117,7 +117,7 @@ func (d ColorCalculationDelegateImpl2021) GetTone(scheme DynamicScheme, dc Dynam
 		return fTone
 	}
 
-	answer := dc.Tone(scheme)
+	answer := dc.InitialTone(scheme)
 	bg := dc.Background
 	contrastCurve := dc.ContrastCurve
 
@@ -196,7 +196,10 @@ func (d ColorCalculationDelegateImpl2025) GetHct(scheme DynamicScheme, dc Dynami
 }
 
 func (d ColorCalculationDelegateImpl2025) GetTone(scheme DynamicScheme, dc DynamicColor) float64 {
-	toneDeltaPair := dc.ToneDeltaPair(scheme)
+	var toneDeltaPair *ToneDeltaPair
+	if dc.ToneDeltaPair != nil {
+		toneDeltaPair = dc.ToneDeltaPair(scheme)
+	}
 	if toneDeltaPair != nil {
 		roleA := toneDeltaPair.RoleA
 		roleB := toneDeltaPair.RoleB
@@ -218,7 +221,7 @@ func (d ColorCalculationDelegateImpl2025) GetTone(scheme DynamicScheme, dc Dynam
 			refRole = roleA
 		}
 
-		selfTone := selfRole.Tone(scheme)
+		selfTone := selfRole.InitialTone(scheme)
 		refTone := refRole.GetTone(scheme)
 		relativeDelta := absoluteDelta
 		if !amRoleA {
@@ -226,15 +229,15 @@ func (d ColorCalculationDelegateImpl2025) GetTone(scheme DynamicScheme, dc Dynam
 		}
 
 		switch constraint {
-		case "exact":
+		case ConstraintExact:
 			selfTone = num.Clamp(0, 100, refTone+relativeDelta)
-		case "nearer":
+		case ConstraintNearer:
 			if relativeDelta > 0 {
 				selfTone = num.Clamp(0, 100, num.Clamp(refTone, refTone+relativeDelta, selfTone))
 			} else {
 				selfTone = num.Clamp(0, 100, num.Clamp(refTone+relativeDelta, refTone, selfTone))
 			}
-		case "farther":
+		case ConstraintFarther:
 			if relativeDelta > 0 {
 				selfTone = num.Clamp(refTone+relativeDelta, 100, selfTone)
 			} else {
@@ -264,7 +267,7 @@ func (d ColorCalculationDelegateImpl2025) GetTone(scheme DynamicScheme, dc Dynam
 		return selfTone
 	}
 
-	answer := dc.Tone(scheme)
+	answer := dc.InitialTone(scheme)
 	bg := dc.Background
 	cc := dc.ContrastCurve
 
@@ -89,9 +89,18 @@ func FromPalette(name string, palette TonalPaletteFn, tone ToneFn) *DynamicColor
 		false, // isBackground
 		nil,   // background
 		nil,   // secondBackground
-		nil,   // contrastCurve
 		nil,   // toneDeltaPair
+		nil,   // contrastCurve
+	}
+}
+
+// InitialTone returns the tone of dc in the given scheme before contrast
+// adjustments. If dc has no Tone function, the tone of its background is used.
+func (dc DynamicColor) InitialTone(scheme DynamicScheme) float64 {
+	if dc.Tone == nil {
+		return GetInitialToneFromBackground(dc.Background)(scheme)
 	}
+	return dc.Tone(scheme)
 }
 
 // GetArgb returns the ARGB value for the DynamicColor in the given scheme
@@ -2,6 +2,14 @@ package dynamic
 
 import "github.com/Nadim147c/material/num"
 
+// Common contrast levels of DynamicScheme. Any value in [-1, 1] is valid.
+const (
+	ContrastReduced  = -1.0
+	ContrastStandard = 0.0
+	ContrastMedium   = 0.5
+	ContrastHigh     = 1.0
+)
+
 // ContrastCurve represents a curve that provides contrast values based on contrast level
 type ContrastCurve struct {
 	low, normal, medium, high float64
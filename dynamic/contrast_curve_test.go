@@ -0,0 +1,35 @@
+package dynamic
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/contrast"
+)
+
+func TestSchemeContrastLevel(t *testing.T) {
+	source := color.ARGB(0xFF0000FF).ToHct()
+	levels := []float64{
+		ContrastReduced, ContrastStandard,
+		ContrastMedium, ContrastHigh,
+	}
+
+	for _, isDark := range []bool{false, true} {
+		prev := 0.0
+		for _, level := range levels {
+			scheme := newTonalSpot(source, isDark, level, Phone, V2021)
+			fg := scheme.OnSurfaceVariant().ToHct().Tone
+			bg := scheme.Surface().ToHct().Tone
+			ratio := contrast.RatioOfTones(fg, bg)
+			if ratio < prev-0.01 {
+				t.Errorf("dark=%v level=%v: contrast = %.2f, want >= %.2f", isDark, level, ratio, prev)
+			}
+			prev = ratio
+		}
+
+		// Highest contrast level reaches 11:1 for on_surface_variant
+		if prev < 11-0.05 {
+			t.Errorf("dark=%v: high contrast = %.2f, want >= 11", isDark, prev)
+		}
+	}
+}
@@ -495,7 +495,7 @@ func (m MaterialColorSpec2021) Primary() *DynamicColor {
 			if IsMonochrome(s) {
 				return ternary(s.IsDark, 100.0, 0.0)
 			}
-			return ternary(s.IsDark, 80.0, 80.0)
+			return ternary(s.IsDark, 80.0, 40.0)
 		},
 		IsBackground: true,
 		Background: func(s DynamicScheme) *DynamicColor {
@@ -572,7 +572,7 @@ func (m MaterialColorSpec2021) OnPrimaryContainer() *DynamicColor {
 		},
 		Tone: func(s DynamicScheme) float64 {
 			if IsFidelity(s) {
-				return ForegroundTone(m.PrimaryContainer().GetTone(s), 4.5)
+				return ForegroundTone(m.PrimaryContainer().Tone(s), 4.5)
 			}
 			if IsMonochrome(s) {
 				return ternary(s.IsDark, 0.0, 100.0)
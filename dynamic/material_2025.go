@@ -5,22 +5,17 @@ import (
 	"github.com/Nadim147c/material/palettes"
 )
 
-// tMaxC
-// Paramters:
-//
-//	lowerBound: 0
-//	upperBound: 100
-//	chromaMultiplier: 1
+// tMaxC returns the tone of palette with the highest chroma, up to
+// palette.Chroma * chromaMultiplier, clamped to [lowerBound, upperBound]. The
+// spec defaults are lowerBound 0, upperBound 100 and chromaMultiplier 1.
 func tMaxC(palette palettes.TonalPalette, lowerBound float64, upperBound float64, chromaMultiplier float64) float64 {
 	answer := FindDesiredChromaByTone(palette.Hue, palette.Chroma*chromaMultiplier, 100, true)
 	return num.Clamp(lowerBound, upperBound, answer)
 }
 
-// tMaxC
-// Paramters:
-//
-//	lowerBound: 0
-//	upperBound: 100
+// tMinC returns the darkest tone of palette that reaches palette.Chroma,
+// clamped to [lowerBound, upperBound]. The spec defaults are lowerBound 0 and
+// upperBound 100.
 func tMinC(palette palettes.TonalPalette, lowerBound float64, upperBound float64) float64 {
 	answer := FindDesiredChromaByTone(palette.Hue, palette.Chroma, 0, false)
 	return num.Clamp(lowerBound, upperBound, answer)
@@ -56,6 +51,44 @@ type MaterialColorSpec2025 struct {
 
 var _ MaterialColorSpec = (*MaterialColorSpec2025)(nil)
 
+// HighestSurface returns the highest surface color based on dark mode
+func (m MaterialColorSpec2025) HighestSurface(s DynamicScheme) *DynamicColor {
+	if s.IsDark {
+		return m.SurfaceBright()
+	}
+	return m.SurfaceDim()
+}
+
+// renamed returns a copy of dc with the given name.
+func renamed(dc *DynamicColor, name string) *DynamicColor {
+	c := *dc
+	c.Name = name
+	return &c
+}
+
+func (m MaterialColorSpec2025) Background() *DynamicColor {
+	return renamed(m.Surface(), "background")
+}
+
+func (m MaterialColorSpec2025) OnBackground() *DynamicColor {
+	dc := renamed(m.OnSurface(), "on_background")
+	dc.Tone = func(s DynamicScheme) float64 {
+		if s.Platform == Watch {
+			return 100
+		}
+		return m.OnSurface().GetTone(s)
+	}
+	return dc
+}
+
+func (m MaterialColorSpec2025) SurfaceVariant() *DynamicColor {
+	return renamed(m.SurfaceContainerHighest(), "surface_variant")
+}
+
+func (m MaterialColorSpec2025) SurfaceTint() *DynamicColor {
+	return renamed(m.Primary(), "surface_tint")
+}
+
 func (m MaterialColorSpec2025) Surface() *DynamicColor {
 	return &DynamicColor{
 		Name:    "surface",
@@ -512,12 +545,15 @@ func (m MaterialColorSpec2025) Primary() *DynamicColor {
 					if s.IsDark {
 						return 80
 					} else {
-						return tMaxC(s.PrimaryPalette, 0, 10, 1)
+						return tMaxC(s.PrimaryPalette, 0, 100, 1)
 					}
 				} else {
 					return tMaxC(s.PrimaryPalette, 0, 90, 1)
 				}
 			case Expressive:
+				if s.Platform != Phone {
+					return tMaxC(s.PrimaryPalette, 0, 100, 1)
+				}
 				if s.PrimaryPalette.IsYellow() {
 					return tMaxC(s.PrimaryPalette, 0, 25, 1)
 				} else if s.PrimaryPalette.IsCyan() {
@@ -526,6 +562,9 @@ func (m MaterialColorSpec2025) Primary() *DynamicColor {
 					return tMaxC(s.PrimaryPalette, 0, 98, 1)
 				}
 			default: // VIBRANT
+				if s.Platform != Phone {
+					return tMaxC(s.PrimaryPalette, 0, 100, 1)
+				}
 				if s.PrimaryPalette.IsCyan() {
 					return tMaxC(s.PrimaryPalette, 0, 88, 1)
 				} else {
@@ -553,7 +592,7 @@ func (m MaterialColorSpec2025) Primary() *DynamicColor {
 					m.PrimaryContainer(),
 					m.Primary(),
 					5,
-					ToneRelativeDarker,
+					ToneRelativeLighter,
 					true,
 					ConstraintFarther,
 				)
@@ -700,6 +739,7 @@ func (m MaterialColorSpec2025) PrimaryFixed() *DynamicColor {
 		Palette: func(s DynamicScheme) palettes.TonalPalette { return s.PrimaryPalette },
 		Tone: func(s DynamicScheme) float64 {
 			s.IsDark = false
+			s.ContrastLevel = 0
 			return m.PrimaryContainer().GetTone(s)
 		},
 		IsBackground: true,
@@ -952,6 +992,7 @@ func (m MaterialColorSpec2025) SecondaryFixed() *DynamicColor {
 		Palette: func(s DynamicScheme) palettes.TonalPalette { return s.SecondaryPalette },
 		Tone: func(s DynamicScheme) float64 {
 			s.IsDark = false
+			s.ContrastLevel = 0
 			return m.SecondaryContainer().GetTone(s)
 		},
 		IsBackground: true,
@@ -1202,11 +1243,32 @@ func (m MaterialColorSpec2025) TertiaryFixed() *DynamicColor {
 		Name:    "tertiary_fixed",
 		Palette: func(s DynamicScheme) palettes.TonalPalette { return s.TertiaryPalette },
 		Tone: func(s DynamicScheme) float64 {
-			temp := s
-			temp.IsDark = false
-			return m.TertiaryContainer().Tone(temp)
+			s.IsDark = false
+			s.ContrastLevel = 0
+			return m.TertiaryContainer().GetTone(s)
+		},
+		IsBackground: true,
+	}
+}
+
+func (m MaterialColorSpec2025) TertiaryFixedDim() *DynamicColor {
+	return &DynamicColor{
+		Name:    "tertiary_fixed_dim",
+		Palette: func(s DynamicScheme) palettes.TonalPalette { return s.TertiaryPalette },
+		Tone: func(s DynamicScheme) float64 {
+			return m.TertiaryFixed().GetTone(s)
 		},
 		IsBackground: true,
+		ToneDeltaPair: func(s DynamicScheme) *ToneDeltaPair {
+			return NewToneDeltaPair(
+				m.TertiaryFixedDim(),
+				m.TertiaryFixed(),
+				5,
+				ToneDarker,
+				true,
+				ConstraintExact,
+			)
+		},
 	}
 }
 
@@ -0,0 +1,32 @@
+package dynamic
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+)
+
+func TestSchemeVersion(t *testing.T) {
+	source := color.ARGB(0xFF0000FF).ToHct()
+	scheme := func(version Version, dark bool) DynamicScheme {
+		return NewDynamicScheme(source, TonalSpot, 0, dark, Phone, version,
+			nil, nil, nil, nil, nil, nil)
+	}
+
+	if scheme(V2021, true).Primary() == scheme(V2025, true).Primary() {
+		t.Error("2021 and 2025 spec produce the same dark primary")
+	}
+
+	// 2025 dark primary sits above its container
+	dark := scheme(V2025, true)
+	primary, container := dark.Primary().ToHct().Tone, dark.PrimaryContainer().ToHct().Tone
+	if primary-container < 5 {
+		t.Errorf("dark primary tone = %.1f, container tone = %.1f, want primary lighter by 5",
+			primary, container)
+	}
+
+	// Unknown versions fall back to 2021
+	if got, want := scheme(2000, false).Primary(), scheme(V2021, false).Primary(); got != want {
+		t.Errorf("unknown version primary = %s, want %s", got.HexRGB(), want.HexRGB())
+	}
+}
@@ -1,35 +1,62 @@
 package dynamic
 
 import (
+	"cmp"
+
 	"github.com/Nadim147c/material/color"
 	"github.com/Nadim147c/material/num"
 	"github.com/Nadim147c/material/palettes"
 )
 
+// Version selects the color spec a DynamicScheme resolves its roles with.
+// Unknown versions use V2021.
 type Version int
 
 const (
+	// V2021 is the original dynamic color spec shipped with Android 12
 	V2021 Version = 2021
+	// V2025 is the updated spec of Material 3 Expressive with new palettes,
+	// platform aware tones and dim roles
 	V2025 Version = 2025
 )
 
+// DynamicScheme is the set of tonal palettes and settings a color scheme is
+// generated from. It can be serialized with encoding/json or any YAML or TOML
+// encoder. MaterialColor is not serialized, a decoded scheme uses the spec of
+// its Version.
+//
+// ContrastLevel is in range [-1, 1], 0 is the standard contrast and 1 is the
+// highest. Tones of color roles move along their contrast curves as the level
+// changes, like the contrast setting of Android.
 type DynamicScheme struct {
-	SourceColorHct color.Hct
-	Variant        Variant
-	IsDark         bool
-	Platform       Platform
-	Version        Version
-	ContrastLevel  float64
-
-	PrimaryPalette        palettes.TonalPalette
-	SecondaryPalette      palettes.TonalPalette
-	TertiaryPalette       palettes.TonalPalette
-	NeutralPalette        palettes.TonalPalette
-	NeutralVariantPalette palettes.TonalPalette
-	ErrorPalette          palettes.TonalPalette
-	MaterialColor         MaterialColorSpec
+	SourceColorHct color.Hct `json:"source_color_hct" yaml:"source_color_hct" toml:"source_color_hct"`
+	Variant        Variant   `json:"variant"          yaml:"variant"          toml:"variant"`
+	IsDark         bool      `json:"is_dark"          yaml:"is_dark"          toml:"is_dark"`
+	Platform       Platform  `json:"platform"         yaml:"platform"         toml:"platform"`
+	Version        Version   `json:"version"          yaml:"version"          toml:"version"`
+	ContrastLevel  float64   `json:"contrast_level"   yaml:"contrast_level"   toml:"contrast_level"`
+
+	PrimaryPalette        palettes.TonalPalette `json:"primary_palette"         yaml:"primary_palette"         toml:"primary_palette"`
+	SecondaryPalette      palettes.TonalPalette `json:"secondary_palette"       yaml:"secondary_palette"       toml:"secondary_palette"`
+	TertiaryPalette       palettes.TonalPalette `json:"tertiary_palette"        yaml:"tertiary_palette"        toml:"tertiary_palette"`
+	NeutralPalette        palettes.TonalPalette `json:"neutral_palette"         yaml:"neutral_palette"         toml:"neutral_palette"`
+	NeutralVariantPalette palettes.TonalPalette `json:"neutral_variant_palette" yaml:"neutral_variant_palette" toml:"neutral_variant_palette"`
+	ErrorPalette          palettes.TonalPalette `json:"error_palette"           yaml:"error_palette"           toml:"error_palette"`
+	MaterialColor         MaterialColorSpec     `json:"-"                       yaml:"-"                       toml:"-"`
+}
+
+// NewMaterialColorSpec returns the MaterialColorSpec of given version.
+func NewMaterialColorSpec(version Version) MaterialColorSpec {
+	if version == V2025 {
+		return &MaterialColorSpec2025{}
+	}
+	return &MaterialColorSpec2021{}
 }
 
+// NewDynamicScheme creates a DynamicScheme from sourceColorHct. Nil palettes
+// are derived from the source color by the palettes delegate of version, for
+// the given variant and platform. An empty platform means Phone. Variant can
+// also be a custom variant added with RegisterVariant.
 func NewDynamicScheme(
 	sourceColorHct color.Hct,
 	variant Variant,
@@ -44,26 +71,44 @@ func NewDynamicScheme(
 	neutralVariantPalette *palettes.TonalPalette,
 	errorPalette *palettes.TonalPalette,
 ) DynamicScheme {
+	if platform == "" {
+		platform = Phone
+	}
+
+	// Custom variants fill missing palettes like TonalSpot
+	paletteVariant := variant
+	if fn, ok := registeredVariant(variant); ok {
+		custom := fn(sourceColorHct, isDark, platform, contrastLevel)
+		primaryPalette = cmp.Or(primaryPalette, custom.Primary)
+		secondaryPalette = cmp.Or(secondaryPalette, custom.Secondary)
+		tertiaryPalette = cmp.Or(tertiaryPalette, custom.Tertiary)
+		neutralPalette = cmp.Or(neutralPalette, custom.Neutral)
+		neutralVariantPalette = cmp.Or(neutralVariantPalette, custom.NeutralVariant)
+		errorPalette = cmp.Or(errorPalette, custom.Error)
+		paletteVariant = TonalSpot
+	}
+
 	var palettesDelegate DynamicSchemePalettesDelegate = &DynamicSchemePalettesDelegateImpl2021{}
-	var colorSpec MaterialColorSpec = &MaterialColorSpec2021{}
 	if version == V2025 {
 		palettesDelegate = &DynamicSchemePalettesDelegateImpl2025{}
-		colorSpec = &MaterialColorSpec2025{}
 	}
 	if primaryPalette == nil {
-		primaryPalette = palettesDelegate.GetPrimaryPalette(variant, sourceColorHct, isDark, Phone, contrastLevel)
+		primaryPalette = palettesDelegate.GetPrimaryPalette(paletteVariant, sourceColorHct, isDark, platform, contrastLevel)
 	}
 	if secondaryPalette == nil {
-		secondaryPalette = palettesDelegate.GetSecondaryPalette(variant, sourceColorHct, isDark, Phone, contrastLevel)
+		secondaryPalette = palettesDelegate.GetSecondaryPalette(paletteVariant, sourceColorHct, isDark, platform, contrastLevel)
 	}
 	if tertiaryPalette == nil {
-		tertiaryPalette = palettesDelegate.GetTertiaryPalette(variant, sourceColorHct, isDark, Phone, contrastLevel)
+		tertiaryPalette = palettesDelegate.GetTertiaryPalette(paletteVariant, sourceColorHct, isDark, platform, contrastLevel)
 	}
 	if neutralPalette == nil {
-		neutralPalette = palettesDelegate.GetNeutralPalette(variant, sourceColorHct, isDark, Phone, contrastLevel)
+		neutralPalette = palettesDelegate.GetNeutralPalette(paletteVariant, sourceColorHct, isDark, platform, contrastLevel)
 	}
 	if neutralVariantPalette == nil {
-		neutralVariantPalette = palettesDelegate.GetNeutralVariantPalette(variant, sourceColorHct, isDark, Phone, contrastLevel)
+		neutralVariantPalette = palettesDelegate.GetNeutralVariantPalette(paletteVariant, sourceColorHct, isDark, platform, contrastLevel)
+	}
+	if errorPalette == nil {
+		errorPalette = palettesDelegate.GetErrorPalette(paletteVariant, sourceColorHct, isDark, platform, contrastLevel)
 	}
 	if errorPalette == nil {
 		errorPalette = palettes.FromHueAndChroma(25.0, 84.0)
@@ -82,7 +127,7 @@ func NewDynamicScheme(
 		NeutralPalette:        *neutralPalette,
 		NeutralVariantPalette: *neutralVariantPalette,
 		ErrorPalette:          *errorPalette,
-		MaterialColor:         colorSpec,
+		MaterialColor:         NewMaterialColorSpec(version),
 	}
 }
 
@@ -116,7 +161,20 @@ func (d DynamicScheme) SourceColorArgb() color.ARGB {
 	return d.SourceColorHct.ToARGB()
 }
 
+// ToARGBMap resolves every color role of ToColorMap for d. Keys are snake case
+// role names, e.g. on_primary_container.
+func (d DynamicScheme) ToARGBMap() map[string]color.ARGB {
+	colors := make(map[string]color.ARGB)
+	for name, dc := range d.ToColorMap() {
+		if dc != nil {
+			colors[name] = dc.GetArgb(d)
+		}
+	}
+	return colors
+}
+
 func (d DynamicScheme) ToColorMap() map[string]*DynamicColor {
+	d.MaterialColor = d.spec()
 	return map[string]*DynamicColor{
 		"primary_palette_key_color":         d.MaterialColor.PrimaryPaletteKeyColor(),
 		"secondary_palette_key_color":       d.MaterialColor.SecondaryPaletteKeyColor(),
@@ -0,0 +1,313 @@
+package dynamic
+
+import "github.com/Nadim147c/material/color"
+
+// spec returns the spec of d.Version, or MaterialColor if it is a custom spec.
+func (d DynamicScheme) spec() MaterialColorSpec {
+	switch d.MaterialColor.(type) {
+	case nil, *MaterialColorSpec2021, *MaterialColorSpec2025:
+		// Built-in specs follow Version, which changes when a scheme is
+		// decoded into an existing one
+		return NewMaterialColorSpec(d.Version)
+	}
+	return d.MaterialColor
+}
+
+// resolve returns the color of dc in d. Roles that do not exist in the spec
+// of d resolve to zero.
+func (d DynamicScheme) resolve(dc *DynamicColor) color.ARGB {
+	if dc == nil {
+		return 0
+	}
+	return dc.GetArgb(d)
+}
+
+// PrimaryPaletteKeyColor returns the primary palette key color.
+func (d DynamicScheme) PrimaryPaletteKeyColor() color.ARGB {
+	return d.resolve(d.spec().PrimaryPaletteKeyColor())
+}
+
+// SecondaryPaletteKeyColor returns the secondary palette key color.
+func (d DynamicScheme) SecondaryPaletteKeyColor() color.ARGB {
+	return d.resolve(d.spec().SecondaryPaletteKeyColor())
+}
+
+// TertiaryPaletteKeyColor returns the tertiary palette key color.
+func (d DynamicScheme) TertiaryPaletteKeyColor() color.ARGB {
+	return d.resolve(d.spec().TertiaryPaletteKeyColor())
+}
+
+// NeutralPaletteKeyColor returns the neutral palette key color.
+func (d DynamicScheme) NeutralPaletteKeyColor() color.ARGB {
+	return d.resolve(d.spec().NeutralPaletteKeyColor())
+}
+
+// NeutralVariantPaletteKeyColor returns the neutral variant palette key color.
+func (d DynamicScheme) NeutralVariantPaletteKeyColor() color.ARGB {
+	return d.resolve(d.spec().NeutralVariantPaletteKeyColor())
+}
+
+// Background returns the background color role.
+func (d DynamicScheme) Background() color.ARGB {
+	return d.resolve(d.spec().Background())
+}
+
+// OnBackground returns the on background color role.
+func (d DynamicScheme) OnBackground() color.ARGB {
+	return d.resolve(d.spec().OnBackground())
+}
+
+// Surface returns the surface color role.
+func (d DynamicScheme) Surface() color.ARGB {
+	return d.resolve(d.spec().Surface())
+}
+
+// SurfaceDim returns the surface dim color role.
+func (d DynamicScheme) SurfaceDim() color.ARGB {
+	return d.resolve(d.spec().SurfaceDim())
+}
+
+// SurfaceBright returns the surface bright color role.
+func (d DynamicScheme) SurfaceBright() color.ARGB {
+	return d.resolve(d.spec().SurfaceBright())
+}
+
+// SurfaceContainerLowest returns the surface container lowest color role.
+func (d DynamicScheme) SurfaceContainerLowest() color.ARGB {
+	return d.resolve(d.spec().SurfaceContainerLowest())
+}
+
+// SurfaceContainerLow returns the surface container low color role.
+func (d DynamicScheme) SurfaceContainerLow() color.ARGB {
+	return d.resolve(d.spec().SurfaceContainerLow())
+}
+
+// SurfaceContainer returns the surface container color role.
+func (d DynamicScheme) SurfaceContainer() color.ARGB {
+	return d.resolve(d.spec().SurfaceContainer())
+}
+
+// SurfaceContainerHigh returns the surface container high color role.
+func (d DynamicScheme) SurfaceContainerHigh() color.ARGB {
+	return d.resolve(d.spec().SurfaceContainerHigh())
+}
+
+// SurfaceContainerHighest returns the surface container highest color role.
+func (d DynamicScheme) SurfaceContainerHighest() color.ARGB {
+	return d.resolve(d.spec().SurfaceContainerHighest())
+}
+
+// OnSurface returns the on surface color role.
+func (d DynamicScheme) OnSurface() color.ARGB {
+	return d.resolve(d.spec().OnSurface())
+}
+
+// SurfaceVariant returns the surface variant color role.
+func (d DynamicScheme) SurfaceVariant() color.ARGB {
+	return d.resolve(d.spec().SurfaceVariant())
+}
+
+// OnSurfaceVariant returns the on surface variant color role.
+func (d DynamicScheme) OnSurfaceVariant() color.ARGB {
+	return d.resolve(d.spec().OnSurfaceVariant())
+}
+
+// InverseSurface returns the inverse surface color role.
+func (d DynamicScheme) InverseSurface() color.ARGB {
+	return d.resolve(d.spec().InverseSurface())
+}
+
+// InverseOnSurface returns the inverse on surface color role.
+func (d DynamicScheme) InverseOnSurface() color.ARGB {
+	return d.resolve(d.spec().InverseOnSurface())
+}
+
+// Outline returns the outline color role.
+func (d DynamicScheme) Outline() color.ARGB {
+	return d.resolve(d.spec().Outline())
+}
+
+// OutlineVariant returns the outline variant color role.
+func (d DynamicScheme) OutlineVariant() color.ARGB {
+	return d.resolve(d.spec().OutlineVariant())
+}
+
+// Shadow returns the shadow color role.
+func (d DynamicScheme) Shadow() color.ARGB {
+	return d.resolve(d.spec().Shadow())
+}
+
+// Scrim returns the scrim color role.
+func (d DynamicScheme) Scrim() color.ARGB {
+	return d.resolve(d.spec().Scrim())
+}
+
+// SurfaceTint returns the surface tint color role.
+func (d DynamicScheme) SurfaceTint() color.ARGB {
+	return d.resolve(d.spec().SurfaceTint())
+}
+
+// Primary returns the primary color role.
+func (d DynamicScheme) Primary() color.ARGB {
+	return d.resolve(d.spec().Primary())
+}
+
+// OnPrimary returns the on primary color role.
+func (d DynamicScheme) OnPrimary() color.ARGB {
+	return d.resolve(d.spec().OnPrimary())
+}
+
+// PrimaryContainer returns the primary container color role.
+func (d DynamicScheme) PrimaryContainer() color.ARGB {
+	return d.resolve(d.spec().PrimaryContainer())
+}
+
+// PrimaryDim returns the primary dim color role. It is zero for V2021 schemes.
+func (d DynamicScheme) PrimaryDim() color.ARGB {
+	return d.resolve(d.spec().PrimaryDim())
+}
+
+// OnPrimaryContainer returns the on primary container color role.
+func (d DynamicScheme) OnPrimaryContainer() color.ARGB {
+	return d.resolve(d.spec().OnPrimaryContainer())
+}
+
+// InversePrimary returns the inverse primary color role.
+func (d DynamicScheme) InversePrimary() color.ARGB {
+	return d.resolve(d.spec().InversePrimary())
+}
+
+// Secondary returns the secondary color role.
+func (d DynamicScheme) Secondary() color.ARGB {
+	return d.resolve(d.spec().Secondary())
+}
+
+// OnSecondary returns the on secondary color role.
+func (d DynamicScheme) OnSecondary() color.ARGB {
+	return d.resolve(d.spec().OnSecondary())
+}
+
+// SecondaryContainer returns the secondary container color role.
+func (d DynamicScheme) SecondaryContainer() color.ARGB {
+	return d.resolve(d.spec().SecondaryContainer())
+}
+
+// SecondaryDim returns the secondary dim color role. It is zero for V2021 schemes.
+func (d DynamicScheme) SecondaryDim() color.ARGB {
+	return d.resolve(d.spec().SecondaryDim())
+}
+
+// OnSecondaryContainer returns the on secondary container color role.
+func (d DynamicScheme) OnSecondaryContainer() color.ARGB {
+	return d.resolve(d.spec().OnSecondaryContainer())
+}
+
+// Tertiary returns the tertiary color role.
+func (d DynamicScheme) Tertiary() color.ARGB {
+	return d.resolve(d.spec().Tertiary())
+}
+
+// OnTertiary returns the on tertiary color role.
+func (d DynamicScheme) OnTertiary() color.ARGB {
+	return d.resolve(d.spec().OnTertiary())
+}
+
+// TertiaryContainer returns the tertiary container color role.
+func (d DynamicScheme) TertiaryContainer() color.ARGB {
+	return d.resolve(d.spec().TertiaryContainer())
+}
+
+// TertiaryDim returns the tertiary dim color role. It is zero for V2021 schemes.
+func (d DynamicScheme) TertiaryDim() color.ARGB {
+	return d.resolve(d.spec().TertiaryDim())
+}
+
+// OnTertiaryContainer returns the on tertiary container color role.
+func (d DynamicScheme) OnTertiaryContainer() color.ARGB {
+	return d.resolve(d.spec().OnTertiaryContainer())
+}
+
+// Error returns the error color role.
+func (d DynamicScheme) Error() color.ARGB {
+	return d.resolve(d.spec().Error())
+}
+
+// OnError returns the on error color role.
+func (d DynamicScheme) OnError() color.ARGB {
+	return d.resolve(d.spec().OnError())
+}
+
+// ErrorContainer returns the error container color role.
+func (d DynamicScheme) ErrorContainer() color.ARGB {
+	return d.resolve(d.spec().ErrorContainer())
+}
+
+// ErrorDim returns the error dim color role. It is zero for V2021 schemes.
+func (d DynamicScheme) ErrorDim() color.ARGB {
+	return d.resolve(d.spec().ErrorDim())
+}
+
+// OnErrorContainer returns the on error container color role.
+func (d DynamicScheme) OnErrorContainer() color.ARGB {
+	return d.resolve(d.spec().OnErrorContainer())
+}
+
+// PrimaryFixed returns the primary fixed color role.
+func (d DynamicScheme) PrimaryFixed() color.ARGB {
+	return d.resolve(d.spec().PrimaryFixed())
+}
+
+// PrimaryFixedDim returns the primary fixed dim color role.
+func (d DynamicScheme) PrimaryFixedDim() color.ARGB {
+	return d.resolve(d.spec().PrimaryFixedDim())
+}
+
+// OnPrimaryFixed returns the on primary fixed color role.
+func (d DynamicScheme) OnPrimaryFixed() color.ARGB {
+	return d.resolve(d.spec().OnPrimaryFixed())
+}
+
+// OnPrimaryFixedVariant returns the on primary fixed variant color role.
+func (d DynamicScheme) OnPrimaryFixedVariant() color.ARGB {
+	return d.resolve(d.spec().OnPrimaryFixedVariant())
+}
+
+// SecondaryFixed returns the secondary fixed color role.
+func (d DynamicScheme) SecondaryFixed() color.ARGB {
+	return d.resolve(d.spec().SecondaryFixed())
+}
+
+// SecondaryFixedDim returns the secondary fixed dim color role.
+func (d DynamicScheme) SecondaryFixedDim() color.ARGB {
+	return d.resolve(d.spec().SecondaryFixedDim())
+}
+
+// OnSecondaryFixed returns the on secondary fixed color role.
+func (d DynamicScheme) OnSecondaryFixed() color.ARGB {
+	return d.resolve(d.spec().OnSecondaryFixed())
+}
+
+// OnSecondaryFixedVariant returns the on secondary fixed variant color role.
+func (d DynamicScheme) OnSecondaryFixedVariant() color.ARGB {
+	return d.resolve(d.spec().OnSecondaryFixedVariant())
+}
+
+// TertiaryFixed returns the tertiary fixed color role.
+func (d DynamicScheme) TertiaryFixed() color.ARGB {
+	return d.resolve(d.spec().TertiaryFixed())
+}
+
+// TertiaryFixedDim returns the tertiary fixed dim color role.
+func (d DynamicScheme) TertiaryFixedDim() color.ARGB {
+	return d.resolve(d.spec().TertiaryFixedDim())
+}
+
+// OnTertiaryFixed returns the on tertiary fixed color role.
+func (d DynamicScheme) OnTertiaryFixed() color.ARGB {
+	return d.resolve(d.spec().OnTertiaryFixed())
+}
+
+// OnTertiaryFixedVariant returns the on tertiary fixed variant color role.
+func (d DynamicScheme) OnTertiaryFixedVariant() color.ARGB {
+	return d.resolve(d.spec().OnTertiaryFixedVariant())
+}
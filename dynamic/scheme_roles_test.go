@@ -0,0 +1,45 @@
+package dynamic
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+)
+
+func TestSchemeRoleAccessors(t *testing.T) {
+	scheme := newTonalSpot(color.ARGB(0xFF6750A4).ToHct(), false, 0, Phone, V2021)
+	colors := scheme.ToARGBMap()
+
+	roles := map[string]color.ARGB{
+		"primary":                   scheme.Primary(),
+		"on_primary_container":      scheme.OnPrimaryContainer(),
+		"surface_container_highest": scheme.SurfaceContainerHighest(),
+		"outline_variant":           scheme.OutlineVariant(),
+		"on_tertiary_fixed_variant": scheme.OnTertiaryFixedVariant(),
+	}
+	for name, got := range roles {
+		if want := colors[name]; got != want {
+			t.Errorf("%s = %s, want %s", name, got.HexRGB(), want.HexRGB())
+		}
+	}
+	if got := scheme.PrimaryDim(); got != 0 {
+		t.Errorf("PrimaryDim() = %s, want 0 for V2021", got.HexRGB())
+	}
+}
+
+func TestScheme2025AliasRoles(t *testing.T) {
+	source := color.ARGB(0xFF0000FF).ToHct()
+	for _, isDark := range []bool{false, true} {
+		scheme := newTonalSpot(source, isDark, 0, Phone, V2025)
+		aliases := [][2]color.ARGB{
+			{scheme.Background(), scheme.Surface()},
+			{scheme.SurfaceVariant(), scheme.SurfaceContainerHighest()},
+			{scheme.SurfaceTint(), scheme.Primary()},
+		}
+		for i, a := range aliases {
+			if a[0] != a[1] {
+				t.Errorf("dark=%v: alias %d = %s, want %s", isDark, i, a[0].HexRGB(), a[1].HexRGB())
+			}
+		}
+	}
+}
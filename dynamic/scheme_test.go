@@ -0,0 +1,162 @@
+package dynamic
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/contrast"
+)
+
+func newTonalSpot(
+	source color.Hct,
+	isDark bool,
+	contrastLevel float64,
+	platform Platform,
+	version Version,
+) DynamicScheme {
+	return NewDynamicScheme(source, TonalSpot, contrastLevel, isDark, platform, version,
+		nil, nil, nil, nil, nil, nil)
+}
+
+func TestSchemeJSONRoundTrip(t *testing.T) {
+	red := color.ARGBFromRGB(0xff, 0, 0)
+	scheme := newTonalSpot(red.ToHct(), true, 0.5, Phone, V2021)
+
+	data, err := json.Marshal(scheme)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var decoded DynamicScheme
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	want := scheme.ToColorMap()
+	for name, got := range decoded.ToColorMap() {
+		if got == nil || want[name] == nil {
+			continue
+		}
+		if g, w := got.GetArgb(decoded), want[name].GetArgb(scheme); g != w {
+			t.Errorf("%s = %s, want %s", name, g.HexRGB(), w.HexRGB())
+		}
+	}
+}
+
+func TestSchemeTextRoundTrip(t *testing.T) {
+	red := color.ARGBFromRGB(0xff, 0, 0)
+	scheme := newTonalSpot(red.ToHct(), true, 0.5, Phone, V2021)
+
+	// encoding/xml encodes color.Hct with MarshalText and palettes field by
+	// field, as YAML and TOML encoders do
+	data, err := xml.Marshal(scheme)
+	if err != nil {
+		t.Fatalf("xml.Marshal() error = %v", err)
+	}
+
+	// Decode into a scheme whose palettes already cached other tones
+	decoded := newTonalSpot(color.ARGB(0xFF00FF00).ToHct(), false, 0, Phone, V2025)
+	decoded.ToARGBMap()
+	if err := xml.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+
+	if decoded.SourceColorHct != scheme.SourceColorHct {
+		t.Errorf("SourceColorHct = %v, want %v", decoded.SourceColorHct, scheme.SourceColorHct)
+	}
+	if g, w := decoded.PrimaryPalette.Tone(40), scheme.PrimaryPalette.Tone(40); g != w {
+		t.Errorf("PrimaryPalette.Tone(40) = %s, want %s", g.HexRGB(), w.HexRGB())
+	}
+	want := scheme.ToARGBMap()
+	for name, got := range decoded.ToARGBMap() {
+		if got != want[name] {
+			t.Errorf("%s = %s, want %s", name, got.HexRGB(), want[name].HexRGB())
+		}
+	}
+}
+
+func TestSchemeResolvesAllRoles(t *testing.T) {
+	source := color.ARGB(0xFF0000FF).ToHct()
+	for _, version := range []Version{V2021, V2025} {
+		for _, variant := range builtinVariants {
+			for _, isDark := range []bool{false, true} {
+				name := fmt.Sprintf("%d/%s/dark=%v", version, variant, isDark)
+				t.Run(name, func(t *testing.T) {
+					scheme := NewDynamicScheme(source, variant, 0, isDark, Phone, version,
+						nil, nil, nil, nil, nil, nil)
+					colors := scheme.ToARGBMap()
+					if len(colors) == 0 {
+						t.Fatal("ToARGBMap() is empty")
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestSchemeContrast(t *testing.T) {
+	source := color.ARGB(0xFF0000FF).ToHct()
+	pairs := []struct {
+		fg, bg string
+		ratio  float64
+	}{
+		{"on_primary", "primary", 4.5},
+		{"on_primary_container", "primary_container", 3},
+		{"on_surface", "highest_surface", 4.5},
+		{"on_surface_variant", "highest_surface", 3},
+	}
+
+	for _, variant := range builtinVariants {
+		for _, isDark := range []bool{false, true} {
+			scheme := NewDynamicScheme(source, variant, 0, isDark, Phone, V2021,
+				nil, nil, nil, nil, nil, nil)
+			colors := scheme.ToARGBMap()
+			colors["highest_surface"] = colors["surface_dim"]
+			if isDark {
+				colors["highest_surface"] = colors["surface_bright"]
+			}
+			for _, p := range pairs {
+				fg, bg := colors[p.fg].ToHct().Tone, colors[p.bg].ToHct().Tone
+				if got := contrast.RatioOfTones(fg, bg); got < p.ratio-0.05 {
+					t.Errorf("%s dark=%v: contrast of %s on %s = %.2f, want >= %v",
+						variant, isDark, p.fg, p.bg, got, p.ratio)
+				}
+			}
+		}
+	}
+}
+
+func TestSchemePlatform(t *testing.T) {
+	source := color.ARGB(0xFF0000FF).ToHct()
+	phone := NewDynamicScheme(source, TonalSpot, 0, true, Phone, V2025,
+		nil, nil, nil, nil, nil, nil)
+	watch := NewDynamicScheme(source, TonalSpot, 0, true, Watch, V2025,
+		nil, nil, nil, nil, nil, nil)
+
+	if phone.PrimaryPalette.Chroma != 26 || watch.PrimaryPalette.Chroma != 32 {
+		t.Errorf("primary chroma phone = %v, watch = %v, want 26, 32",
+			phone.PrimaryPalette.Chroma, watch.PrimaryPalette.Chroma)
+	}
+	if phone.ErrorPalette.Chroma != 60 || watch.ErrorPalette.Chroma != 48 {
+		t.Errorf("error chroma phone = %v, watch = %v, want 60, 48",
+			phone.ErrorPalette.Chroma, watch.ErrorPalette.Chroma)
+	}
+
+	// Watches use black surfaces
+	if got := watch.Surface(); got != 0xFF000000 {
+		t.Errorf("watch surface = %s, want #000000", got.HexRGB())
+	}
+	if got := phone.Surface(); got == 0xFF000000 {
+		t.Errorf("phone surface = %s, want non black", got.HexRGB())
+	}
+
+	// Empty platform is a phone
+	empty := NewDynamicScheme(source, TonalSpot, 0, true, "", V2025,
+		nil, nil, nil, nil, nil, nil)
+	if empty.Platform != Phone || empty.Primary() != phone.Primary() {
+		t.Errorf("empty platform = %q, want %q", empty.Platform, Phone)
+	}
+}
@@ -8,10 +8,14 @@ import (
 	"github.com/Nadim147c/material/temperature"
 )
 
+// Platform is the device a scheme is generated for. The 2025 spec uses darker
+// surfaces and different chroma and tones on watches. The 2021 spec ignores it.
 type Platform string
 
 const (
+	// Phone is the default platform
 	Phone Platform = "phone"
+	// Watch uses black surfaces and tones suited for small OLED displays
 	Watch Platform = "watch"
 )
 
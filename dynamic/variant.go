@@ -1,5 +1,15 @@
 package dynamic
 
+import (
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/palettes"
+)
+
 type Variant string
 
 const (
@@ -13,3 +23,87 @@ const (
 	Rainbow    Variant = "rainbow"
 	FruitSalad Variant = "fruit_salad"
 )
+
+// builtinVariants are the variants defined by the Material spec.
+var builtinVariants = []Variant{
+	Monochrome, Neutral, TonalSpot, Vibrant, Expressive, Fidelity, Content, Rainbow, FruitSalad,
+}
+
+// VariantPalettes are the palettes of a custom variant. Nil palettes are
+// derived from the source color like TonalSpot.
+type VariantPalettes struct {
+	Primary        *palettes.TonalPalette
+	Secondary      *palettes.TonalPalette
+	Tertiary       *palettes.TonalPalette
+	Neutral        *palettes.TonalPalette
+	NeutralVariant *palettes.TonalPalette
+	Error          *palettes.TonalPalette
+}
+
+// VariantPalettesFunc returns the palettes of a custom variant for a source
+// color.
+type VariantPalettesFunc func(
+	sourceColorHct color.Hct, isDark bool, platform Platform, contrastLevel float64,
+) VariantPalettes
+
+var (
+	// ErrVariantExists is returned when registering a variant name that is
+	// already in use.
+	ErrVariantExists = errors.New("variant already exists")
+	// ErrNilVariantFunc is returned when registering a variant without palettes
+	// function.
+	ErrNilVariantFunc = errors.New("variant palettes function is nil")
+)
+
+var variantRegistry = struct {
+	sync.RWMutex
+	funcs map[Variant]VariantPalettesFunc
+}{funcs: map[Variant]VariantPalettesFunc{}}
+
+// RegisterVariant registers a custom variant, e.g. "pastel", so it can be
+// passed to NewDynamicScheme like the built-in ones. Palettes of the variant
+// are created by fn. Color roles of custom variants follow the rules of the
+// color spec for variants without special handling, which is close to
+// TonalSpot. Built-in and already registered names are rejected with
+// ErrVariantExists. It is safe to call from multiple goroutines.
+func RegisterVariant(name Variant, fn VariantPalettesFunc) error {
+	if fn == nil {
+		return ErrNilVariantFunc
+	}
+	if slices.Contains(builtinVariants, name) {
+		return ErrVariantExists
+	}
+
+	variantRegistry.Lock()
+	defer variantRegistry.Unlock()
+	if _, ok := variantRegistry.funcs[name]; ok {
+		return ErrVariantExists
+	}
+	variantRegistry.funcs[name] = fn
+	return nil
+}
+
+// unregisterVariant removes a custom variant. It lets tests clean up the
+// process wide registry.
+func unregisterVariant(name Variant) {
+	variantRegistry.Lock()
+	defer variantRegistry.Unlock()
+	delete(variantRegistry.funcs, name)
+}
+
+// registeredVariant returns the palettes function of a custom variant.
+func registeredVariant(name Variant) (VariantPalettesFunc, bool) {
+	variantRegistry.RLock()
+	defer variantRegistry.RUnlock()
+	fn, ok := variantRegistry.funcs[name]
+	return fn, ok
+}
+
+// Variants returns the built-in variants followed by registered custom
+// variants in sorted order.
+func Variants() []Variant {
+	variantRegistry.RLock()
+	custom := slices.Sorted(maps.Keys(variantRegistry.funcs))
+	variantRegistry.RUnlock()
+	return append(slices.Clone(builtinVariants), custom...)
+}
@@ -0,0 +1,58 @@
+package dynamic
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/palettes"
+)
+
+func TestRegisterVariant(t *testing.T) {
+	pastel := Variant("pastel")
+	err := RegisterVariant(pastel, func(
+		source color.Hct, isDark bool, platform Platform, contrastLevel float64,
+	) VariantPalettes {
+		return VariantPalettes{
+			Primary:   palettes.FromHueAndChroma(source.Hue, 20),
+			Secondary: palettes.FromHueAndChroma(source.Hue+30, 12),
+		}
+	})
+	if err != nil {
+		t.Fatalf("RegisterVariant() error = %v", err)
+	}
+	t.Cleanup(func() { unregisterVariant(pastel) })
+
+	if err := RegisterVariant(pastel, func(color.Hct, bool, Platform, float64) VariantPalettes {
+		return VariantPalettes{}
+	}); !errors.Is(err, ErrVariantExists) {
+		t.Errorf("RegisterVariant(duplicate) error = %v, want ErrVariantExists", err)
+	}
+	if err := RegisterVariant(TonalSpot, nil); !errors.Is(err, ErrNilVariantFunc) {
+		t.Errorf("RegisterVariant(nil) error = %v, want ErrNilVariantFunc", err)
+	}
+	if !slices.Contains(Variants(), pastel) {
+		t.Errorf("Variants() = %v, want to contain %q", Variants(), pastel)
+	}
+
+	source := color.ARGB(0xFF0000FF).ToHct()
+	for _, version := range []Version{V2021, V2025} {
+		scheme := NewDynamicScheme(source, pastel, 0, false, Phone, version,
+			nil, nil, nil, nil, nil, nil)
+		tonalSpot := NewDynamicScheme(source, TonalSpot, 0, false, Phone, version,
+			nil, nil, nil, nil, nil, nil)
+
+		if scheme.PrimaryPalette.Chroma != 20 || scheme.SecondaryPalette.Chroma != 12 {
+			t.Errorf("%d: palettes chroma = %v, %v, want 20, 12", version,
+				scheme.PrimaryPalette.Chroma, scheme.SecondaryPalette.Chroma)
+		}
+		if scheme.TertiaryPalette.Hue != tonalSpot.TertiaryPalette.Hue {
+			t.Errorf("%d: tertiary hue = %v, want tonal spot %v", version,
+				scheme.TertiaryPalette.Hue, tonalSpot.TertiaryPalette.Hue)
+		}
+		if got := len(scheme.ToARGBMap()); got != len(tonalSpot.ToARGBMap()) {
+			t.Errorf("%d: resolved %d roles, want %d", version, got, len(tonalSpot.ToARGBMap()))
+		}
+	}
+}
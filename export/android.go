@@ -0,0 +1,93 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/theme"
+)
+
+// Paths of the Android resource files returned by Android, relative to the res
+// directory.
+const (
+	AndroidLightPath = "values/colors.xml"
+	AndroidNightPath = "values-night/colors.xml"
+)
+
+// androidHex returns #RRGGBB, or #AARRGGBB for translucent colors as expected
+// by Android resources.
+func androidHex(c color.ARGB) string {
+	if c.Alpha() == 0xFF {
+		return c.HexRGB()
+	}
+	return c.HexARGB()
+}
+
+// androidName normalizes name to a valid Android resource name. Names are
+// lower cased, runs of characters other than letters and digits become a
+// single underscore and names starting with a digit get a "color_" prefix.
+func androidName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	})
+	name = strings.Join(words, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "color_" + name
+	}
+	return name
+}
+
+// AndroidXML renders colors as an Android resources file. Android resource
+// names can not contain dashes, so names are always written in snake case and
+// the Naming option is ignored. Names that are still not valid resource names,
+// e.g. custom color names with spaces or symbols, are normalized by replacing
+// invalid characters with underscores.
+//
+//	<?xml version="1.0" encoding="utf-8"?>
+//	<resources>
+//	    <color name="primary">#6750A4</color>
+//	</resources>
+func AndroidXML(colors map[string]color.ARGB, opts Options) string {
+	opts.Naming = SnakeCase
+
+	var b strings.Builder
+	b.WriteString("<?xml version=\"1.0\" encoding=\"utf-8\"?>\n<resources>\n")
+	for _, role := range sortedNames(colors) {
+		b.WriteString("    <color name=\"")
+		xml.EscapeText(&b, []byte(androidName(opts.name(role))))
+		fmt.Fprintf(&b, "\">%s</color>\n", androidHex(colors[role]))
+	}
+	b.WriteString("</resources>\n")
+	return b.String()
+}
+
+// Android renders the light and dark schemes of t, including its custom
+// colors, as Android resource files keyed by AndroidLightPath and
+// AndroidNightPath. Both files use the same names, so Android picks the night
+// colors automatically in dark mode. Custom color names are normalized like in
+// AndroidXML. An error is returned if a custom color role has the name of a
+// scheme role or of another custom color role, e.g. "error" or "Brand 1" next
+// to "brand_1".
+func Android(t *theme.Theme, opts Options) (map[string]string, error) {
+	light := t.Schemes.Light.ToARGBMap()
+	dark := t.Schemes.Dark.ToARGBMap()
+	for _, group := range t.CustomColors {
+		name := androidName(group.Color.Name)
+		roles := group.Light.ToARGBMap(name)
+		for role := range roles {
+			if _, ok := light[role]; ok {
+				return nil, fmt.Errorf("custom color %q: color %q is already defined", group.Color.Name, role)
+			}
+		}
+		maps.Copy(light, roles)
+		maps.Copy(dark, group.Dark.ToARGBMap(name))
+	}
+
+	return map[string]string{
+		AndroidLightPath: AndroidXML(light, opts),
+		AndroidNightPath: AndroidXML(dark, opts),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package export
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/schemes"
+	"github.com/Nadim147c/material/theme"
+)
+
+func TestAndroidXML(t *testing.T) {
+	colors := map[string]color.ARGB{
+		"on_primary": 0xFFFFFFFF,
+		"scrim":      0x80000000,
+	}
+
+	got := AndroidXML(colors, Options{Prefix: "md-theme", Naming: KebabCase})
+	want := `<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <color name="md_theme_on_primary">#FFFFFF</color>
+    <color name="md_theme_scrim">#80000000</color>
+</resources>
+`
+	if got != want {
+		t.Errorf("AndroidXML() = %q, want %q", got, want)
+	}
+}
+
+func TestAndroidXML_Names(t *testing.T) {
+	colors := map[string]color.ARGB{
+		`Brand "Blue" & <Co>`: 0xFF0000FF,
+		"2nd.accent":          0xFFFF0000,
+	}
+
+	got := AndroidXML(colors, Options{})
+	var res struct {
+		Colors []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"color"`
+	}
+	if err := xml.Unmarshal([]byte(got), &res); err != nil {
+		t.Fatalf("AndroidXML() is not valid XML: %v\n%s", err, got)
+	}
+
+	want := []string{"color_2nd_accent", "brand_blue_co"}
+	for i, c := range res.Colors {
+		if c.Name != want[i] {
+			t.Errorf("name %d = %q, want %q", i, c.Name, want[i])
+		}
+	}
+}
+
+func TestAndroid(t *testing.T) {
+	th := theme.FromSource(0xFF6750A4, schemes.CustomColor{Name: "brand", Value: 0xFF00FF00, Blend: true})
+	files, err := Android(th, Options{})
+	if err != nil {
+		t.Fatalf("Android() error = %v", err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("Android() returned %d files, want 2", len(files))
+	}
+
+	for path, scheme := range map[string]schemes.Scheme{
+		AndroidLightPath: th.Schemes.Light,
+		AndroidNightPath: th.Schemes.Dark,
+	} {
+		var res struct {
+			Colors []struct {
+				Name  string `xml:"name,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"color"`
+		}
+		if err := xml.Unmarshal([]byte(files[path]), &res); err != nil {
+			t.Fatalf("%s is not valid XML: %v", path, err)
+		}
+
+		colors := make(map[string]string, len(res.Colors))
+		for _, c := range res.Colors {
+			if strings.Contains(c.Name, "-") {
+				t.Errorf("%s has invalid resource name %q", path, c.Name)
+			}
+			colors[c.Name] = c.Value
+		}
+		if got, want := colors["primary"], scheme.Primary.HexRGB(); got != want {
+			t.Errorf("%s primary = %s, want %s", path, got, want)
+		}
+		if _, ok := colors["on_brand_container"]; !ok {
+			t.Errorf("%s is missing on_brand_container", path)
+		}
+	}
+}
+
+func TestAndroid_NameCollision(t *testing.T) {
+	for name, custom := range map[string][]schemes.CustomColor{
+		"scheme role": {{Name: "Error", Value: 0xFFFF0000}},
+		"custom color": {
+			{Name: "Brand 1", Value: 0xFF00FF00},
+			{Name: "brand_1", Value: 0xFF0000FF},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			th := theme.FromSource(0xFF6750A4, custom...)
+			if _, err := Android(th, Options{}); err == nil {
+				t.Errorf("Android() with custom colors %v succeeded", custom)
+			}
+		})
+	}
+}
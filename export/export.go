@@ -0,0 +1,174 @@
+// Package export renders palettes and schemes as source code for other tools
+// like CSS, SCSS and LESS.
+package export
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/dynamic"
+	"github.com/Nadim147c/material/palettes"
+)
+
+// NamingConvention controls how role names are written in exported files.
+type NamingConvention int
+
+const (
+	// KebabCase writes names like on-primary-container.
+	KebabCase NamingConvention = iota
+	// CamelCase writes names like onPrimaryContainer.
+	CamelCase
+	// SnakeCase writes names like on_primary_container.
+	SnakeCase
+)
+
+// Format converts a snake case name to n.
+func (n NamingConvention) Format(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-' || r == ' '
+	})
+	switch n {
+	case CamelCase:
+		for i := 1; i < len(words); i++ {
+			words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+		}
+		return strings.Join(words, "")
+	case SnakeCase:
+		return strings.Join(words, "_")
+	default:
+		return strings.Join(words, "-")
+	}
+}
+
+// Options configures exporters.
+type Options struct {
+	// Naming is the naming convention of variables
+	Naming NamingConvention
+	// Prefix is prepended to every variable name, e.g. "md-sys-color"
+	Prefix string
+}
+
+// name returns the variable name of a role.
+func (o Options) name(role string) string {
+	if o.Prefix == "" {
+		return o.Naming.Format(role)
+	}
+	return o.Naming.Format(o.Prefix + "_" + role)
+}
+
+// hex returns #RRGGBB, or #RRGGBBAA for translucent colors.
+func hex(c color.ARGB) string {
+	if c.Alpha() == 0xFF {
+		return c.HexRGB()
+	}
+	return c.HexRGBA()
+}
+
+// sortedNames returns the keys of colors in sorted order.
+func sortedNames(colors map[string]color.ARGB) []string {
+	return slices.Sorted(maps.Keys(colors))
+}
+
+// SchemeColors returns every color role of scheme keyed by snake case role
+// name.
+func SchemeColors(scheme dynamic.DynamicScheme) map[string]color.ARGB {
+	return scheme.ToARGBMap()
+}
+
+// PaletteColors returns tones of palette keyed by name and tone, e.g.
+// primary_40. If tones is empty palettes.StandardTones are used.
+func PaletteColors(name string, palette *palettes.TonalPalette, tones ...float64) map[string]color.ARGB {
+	colors := make(map[string]color.ARGB, len(palettes.StandardTones))
+	for tone, c := range palette.Tones(tones...) {
+		colors[fmt.Sprintf("%s_%g", name, tone)] = c
+	}
+	return colors
+}
+
+// CSS renders colors as custom properties inside a :root rule.
+//
+//	:root {
+//	  --primary: #6750A4;
+//	}
+func CSS(colors map[string]color.ARGB, opts Options) string {
+	var b strings.Builder
+	b.WriteString(":root {\n")
+	for _, role := range sortedNames(colors) {
+		fmt.Fprintf(&b, "  --%s: %s;\n", opts.name(role), hex(colors[role]))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// SCSS renders colors as SCSS variables followed by a map of all colors named
+// colors.
+//
+//	$primary: #6750A4;
+//	$colors: (
+//	  "primary": $primary,
+//	);
+func SCSS(colors map[string]color.ARGB, opts Options) string {
+	var b strings.Builder
+	names := sortedNames(colors)
+	for _, role := range names {
+		fmt.Fprintf(&b, "$%s: %s;\n", opts.name(role), hex(colors[role]))
+	}
+	fmt.Fprintf(&b, "\n$%s: (\n", opts.name("colors"))
+	for _, role := range names {
+		name := opts.name(role)
+		fmt.Fprintf(&b, "  \"%s\": $%s,\n", name, name)
+	}
+	b.WriteString(");\n")
+	return b.String()
+}
+
+// LESS renders colors as LESS variables.
+//
+//	@primary: #6750A4;
+func LESS(colors map[string]color.ARGB, opts Options) string {
+	var b strings.Builder
+	for _, role := range sortedNames(colors) {
+		fmt.Fprintf(&b, "@%s: %s;\n", opts.name(role), hex(colors[role]))
+	}
+	return b.String()
+}
+
+// Tailwind renders a set of palettes as a Tailwind CSS configuration fragment of
+// theme.extend.colors in JSON. Each palette becomes a color family whose keys
+// are tones, e.g. primary-40. If tones is empty palettes.StandardTones are used. The
+// Prefix option is ignored.
+func Tailwind(set map[string]*palettes.TonalPalette, opts Options, tones ...float64) string {
+	if len(tones) == 0 {
+		tones = palettes.StandardTones
+	}
+
+	var b strings.Builder
+	b.WriteString("{\n  \"theme\": {\n    \"extend\": {\n      \"colors\": {\n")
+	names := slices.Sorted(maps.Keys(set))
+	for i, name := range names {
+		fmt.Fprintf(&b, "        %q: {\n", opts.Naming.Format(name))
+		for j, tone := range tones {
+			sep := ","
+			if j == len(tones)-1 {
+				sep = ""
+			}
+			c := set[name].Tone(tone)
+			fmt.Fprintf(&b, "          \"%g\": %q%s\n", tone, hex(c), sep)
+		}
+		b.WriteString("        }")
+		if i != len(names)-1 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("      }\n    }\n  }\n}\n")
+	return b.String()
+}
+
+// TailwindJS is like Tailwind but renders a tailwind.config.js module.
+func TailwindJS(set map[string]*palettes.TonalPalette, opts Options, tones ...float64) string {
+	return "module.exports = " + strings.TrimSuffix(Tailwind(set, opts, tones...), "\n") + ";\n"
+}
@@ -0,0 +1,109 @@
+package export
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/dynamic"
+	"github.com/Nadim147c/material/palettes"
+	"github.com/Nadim147c/material/schemes"
+)
+
+func TestNamingConvention_Format(t *testing.T) {
+	tests := []struct {
+		naming NamingConvention
+		want   string
+	}{
+		{KebabCase, "on-primary-container"},
+		{CamelCase, "onPrimaryContainer"},
+		{SnakeCase, "on_primary_container"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.naming.Format("on_primary_container"); got != tt.want {
+			t.Errorf("Format() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestExporters(t *testing.T) {
+	colors := map[string]color.ARGB{
+		"primary":    0xFF6750A4,
+		"on_primary": 0xFFFFFFFF,
+		"scrim":      0x80000000,
+	}
+
+	css := CSS(colors, Options{Prefix: "md-sys-color"})
+	want := ":root {\n  --md-sys-color-on-primary: #FFFFFF;\n  --md-sys-color-primary: #6750A4;\n  --md-sys-color-scrim: #00000080;\n}\n"
+	if css != want {
+		t.Errorf("CSS() = %q, want %q", css, want)
+	}
+
+	scss := SCSS(colors, Options{Naming: SnakeCase})
+	for _, line := range []string{
+		"$on_primary: #FFFFFF;\n",
+		"$colors: (\n",
+		"  \"primary\": $primary,\n",
+	} {
+		if !strings.Contains(scss, line) {
+			t.Errorf("SCSS() = %q, want to contain %q", scss, line)
+		}
+	}
+
+	less := LESS(colors, Options{Naming: CamelCase})
+	want = "@onPrimary: #FFFFFF;\n@primary: #6750A4;\n@scrim: #00000080;\n"
+	if less != want {
+		t.Errorf("LESS() = %q, want %q", less, want)
+	}
+}
+
+func TestSchemeAndPaletteColors(t *testing.T) {
+	scheme := schemes.NewTonalSpot(color.ARGB(0xFF6750A4).ToHct(), false, 0, dynamic.Phone, dynamic.V2021)
+	colors := SchemeColors(scheme)
+	if _, ok := colors["on_primary_container"]; !ok {
+		t.Errorf("SchemeColors() is missing on_primary_container")
+	}
+
+	palette := palettes.NewFromARGB(0xFF6750A4)
+	tones := PaletteColors("primary", palette, 0, 40, 100)
+	if len(tones) != 3 || tones["primary_0"] != 0xFF000000 || tones["primary_100"] != 0xFFFFFFFF {
+		t.Errorf("PaletteColors() = %v", tones)
+	}
+	if got := len(PaletteColors("primary", palette)); got != len(palettes.StandardTones) {
+		t.Errorf("len(PaletteColors()) = %d, want %d", got, len(palettes.StandardTones))
+	}
+}
+
+func TestTailwind(t *testing.T) {
+	set := map[string]*palettes.TonalPalette{
+		"primary":         palettes.NewFromARGB(0xFF6750A4),
+		"neutral_variant": palettes.FromHueAndChroma(0, 0),
+	}
+
+	got := Tailwind(set, Options{}, 0, 100)
+	var config struct {
+		Theme struct {
+			Extend struct {
+				Colors map[string]map[string]string `json:"colors"`
+			} `json:"extend"`
+		} `json:"theme"`
+	}
+	if err := json.Unmarshal([]byte(got), &config); err != nil {
+		t.Fatalf("Tailwind() is not valid JSON: %v\n%s", err, got)
+	}
+
+	colors := config.Theme.Extend.Colors
+	if colors["primary"]["0"] != "#000000" || colors["primary"]["100"] != "#FFFFFF" {
+		t.Errorf("Tailwind() primary = %v", colors["primary"])
+	}
+	if _, ok := colors["neutral-variant"]; !ok {
+		t.Errorf("Tailwind() is missing neutral-variant: %v", colors)
+	}
+
+	js := TailwindJS(set, Options{})
+	if !strings.HasPrefix(js, "module.exports = {") || !strings.HasSuffix(js, "};\n") {
+		t.Errorf("TailwindJS() = %q", js)
+	}
+}
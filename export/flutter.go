@@ -0,0 +1,60 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Nadim147c/material/color"
+)
+
+// flutterRoles are the roles accepted by the ColorScheme constructor of
+// Flutter in the order of its parameters. Deprecated parameters like
+// background and surfaceVariant are left out.
+var flutterRoles = []string{
+	"primary", "on_primary", "primary_container", "on_primary_container",
+	"primary_fixed", "primary_fixed_dim", "on_primary_fixed", "on_primary_fixed_variant",
+	"secondary", "on_secondary", "secondary_container", "on_secondary_container",
+	"secondary_fixed", "secondary_fixed_dim", "on_secondary_fixed", "on_secondary_fixed_variant",
+	"tertiary", "on_tertiary", "tertiary_container", "on_tertiary_container",
+	"tertiary_fixed", "tertiary_fixed_dim", "on_tertiary_fixed", "on_tertiary_fixed_variant",
+	"error", "on_error", "error_container", "on_error_container",
+	"surface", "on_surface", "surface_dim", "surface_bright",
+	"surface_container_lowest", "surface_container_low", "surface_container",
+	"surface_container_high", "surface_container_highest", "on_surface_variant",
+	"outline", "outline_variant", "shadow", "scrim",
+	"inverse_surface", "inverse_on_surface", "inverse_primary", "surface_tint",
+}
+
+// flutterName returns the ColorScheme parameter of a snake case role name.
+func flutterName(role string) string {
+	if role == "inverse_on_surface" {
+		return "onInverseSurface"
+	}
+	return CamelCase.Format(role)
+}
+
+// Flutter renders colors as a Dart ColorScheme constructor of Flutter. Colors
+// are written as Color(0xAARRGGBB) literals. Roles that ColorScheme does not
+// accept are skipped.
+//
+//	const ColorScheme(
+//	  brightness: Brightness.light,
+//	  primary: Color(0xFF6750A4),
+//	)
+func Flutter(colors map[string]color.ARGB, isDark bool) string {
+	brightness := "light"
+	if isDark {
+		brightness = "dark"
+	}
+
+	var b strings.Builder
+	b.WriteString("const ColorScheme(\n")
+	fmt.Fprintf(&b, "  brightness: Brightness.%s,\n", brightness)
+	for _, role := range flutterRoles {
+		if c, ok := colors[role]; ok {
+			fmt.Fprintf(&b, "  %s: Color(0x%08X),\n", flutterName(role), uint32(c))
+		}
+	}
+	b.WriteString(")\n")
+	return b.String()
+}
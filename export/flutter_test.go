@@ -0,0 +1,37 @@
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/dynamic"
+	"github.com/Nadim147c/material/schemes"
+)
+
+func TestFlutter(t *testing.T) {
+	colors := map[string]color.ARGB{
+		"primary":                   0xFF6750A4,
+		"inverse_on_surface":        0xFFF5EFF7,
+		"scrim":                     0x80000000,
+		"background":                0xFFFFFFFF,
+		"primary_palette_key_color": 0xFF6750A4,
+	}
+
+	got := Flutter(colors, true)
+	want := "const ColorScheme(\n" +
+		"  brightness: Brightness.dark,\n" +
+		"  primary: Color(0xFF6750A4),\n" +
+		"  scrim: Color(0x80000000),\n" +
+		"  onInverseSurface: Color(0xFFF5EFF7),\n" +
+		")\n"
+	if got != want {
+		t.Errorf("Flutter() = %q, want %q", got, want)
+	}
+
+	scheme := schemes.NewTonalSpot(color.ARGB(0xFF6750A4).ToHct(), false, 0, dynamic.Phone, dynamic.V2021)
+	got = Flutter(SchemeColors(scheme), false)
+	if n := strings.Count(got, "Color(0x"); n != len(flutterRoles) {
+		t.Errorf("Flutter() has %d colors, want %d", n, len(flutterRoles))
+	}
+}
@@ -92,19 +92,14 @@ func Degree(rad float64) float64 {
 	return (rad * 180) / math.Pi
 }
 
+// RotationDirection returns the sign of the shortest rotation from one angle
+// to another in degrees. It returns 1.0 for counterclockwise (increasing)
+// rotation and -1.0 otherwise. Opposite angles rotate counterclockwise.
 func RotationDirection(from float64, to float64) float64 {
-	a := to - from
-	b := to - from + 360.0
-	c := to - from - 360.0
-
-	aAbs, bAbs, cAbs := math.Abs(a), math.Abs(b), math.Abs(c)
-	if aAbs <= bAbs && aAbs <= cAbs {
-		return Sign(a)
-	} else if bAbs <= aAbs && bAbs <= cAbs {
-		return Sign(b)
-	} else {
-		return Sign(c)
+	if NormalizeDegree(to-from) <= 180.0 {
+		return 1.0
 	}
+	return -1.0
 }
 
 // DifferenceDegrees of two points on a circle, represented using degrees.
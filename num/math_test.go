@@ -0,0 +1,43 @@
+package num
+
+import "testing"
+
+func TestRotationDirection(t *testing.T) {
+	tests := []struct {
+		from, to float64
+		want     float64
+	}{
+		{0, 90, 1},
+		{90, 0, -1},
+		{350, 10, 1},
+		{10, 350, -1},
+		{0, 180, 1},
+		{180, 0, 1},
+		{45, 45, 1},
+	}
+
+	for _, tt := range tests {
+		if got := RotationDirection(tt.from, tt.to); got != tt.want {
+			t.Errorf("RotationDirection(%v, %v) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestDifferenceDegrees(t *testing.T) {
+	tests := []struct {
+		a, b float64
+		want float64
+	}{
+		{0, 90, 90},
+		{350, 10, 20},
+		{10, 350, 20},
+		{0, 180, 180},
+		{45, 45, 0},
+	}
+
+	for _, tt := range tests {
+		if got := DifferenceDegrees(tt.a, tt.b); got != tt.want {
+			t.Errorf("DifferenceDegrees(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
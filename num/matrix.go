@@ -30,6 +30,19 @@ func (m Matrix3) Multiply(v Vector3) Vector3 {
 	return result
 }
 
+// MultiplyMatrix returns the matrix product m × other
+func (m Matrix3) MultiplyMatrix(other Matrix3) Matrix3 {
+	var result Matrix3
+	for i := range 3 {
+		for j := range 3 {
+			for k := range 3 {
+				result[i][j] += m[i][k] * other[k][j]
+			}
+		}
+	}
+	return result
+}
+
 // Transpose transposes the Matrix3
 func (m Matrix3) Transpose() Matrix3 {
 	var result Matrix3
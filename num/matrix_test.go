@@ -85,6 +85,43 @@ func TestMatrixMultiply(t *testing.T) {
 	}
 }
 
+// TestMatrixMultiplyMatrix tests multiplying two matrices
+func TestMatrixMultiplyMatrix(t *testing.T) {
+	a := NewMatrix3(
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9,
+	)
+	b := NewMatrix3(
+		9, 8, 7,
+		6, 5, 4,
+		3, 2, 1,
+	)
+
+	expected := NewMatrix3(
+		30, 24, 18,
+		84, 69, 54,
+		138, 114, 90,
+	)
+
+	result := a.MultiplyMatrix(b)
+	for i := range 3 {
+		for j := range 3 {
+			if !almostEqual(result[i][j], expected[i][j]) {
+				t.Errorf("Matrix element [%d][%d]: expected %f, got %f", i, j, expected[i][j], result[i][j])
+			}
+		}
+	}
+
+	// Multiplying a vector by the product must equal applying both matrices
+	v := NewVector3(1, 2, 3)
+	x1, y1, z1 := result.Multiply(v).Values()
+	x2, y2, z2 := a.Multiply(b.Multiply(v)).Values()
+	if !almostEqual(x1, x2) || !almostEqual(y1, y2) || !almostEqual(z1, z2) {
+		t.Errorf("(A*B)*v = (%f,%f,%f), A*(B*v) = (%f,%f,%f)", x1, y1, z1, x2, y2, z2)
+	}
+}
+
 // TestVectorMultiplyMatrix tests multiplying a vector with a matrix
 func TestVectorMultiplyMatrix(t *testing.T) {
 	// Test matrix
@@ -0,0 +1,55 @@
+package palettes
+
+import (
+	"math"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/num"
+)
+
+// CorePalette is the set of key tonal palettes of a Material 3 color scheme.
+type CorePalette struct {
+	Primary        *TonalPalette `json:"primary"         yaml:"primary"         toml:"primary"`
+	Secondary      *TonalPalette `json:"secondary"       yaml:"secondary"       toml:"secondary"`
+	Tertiary       *TonalPalette `json:"tertiary"        yaml:"tertiary"        toml:"tertiary"`
+	Neutral        *TonalPalette `json:"neutral"         yaml:"neutral"         toml:"neutral"`
+	NeutralVariant *TonalPalette `json:"neutral_variant" yaml:"neutral_variant" toml:"neutral_variant"`
+	Error          *TonalPalette `json:"error"           yaml:"error"           toml:"error"`
+}
+
+// CorePaletteOf creates a CorePalette from seed with the standard chroma rules
+// of Material 3. Primary chroma is at least 48 and the other palettes use
+// fixed chroma.
+func CorePaletteOf(seed color.ARGB) *CorePalette {
+	hct := seed.ToHct()
+	return newCorePalette(hct.Hue, hct.Chroma, false)
+}
+
+// ContentCorePaletteOf creates a CorePalette from seed that preserves chroma
+// of seed. This is suited for colors extracted from content like images.
+func ContentCorePaletteOf(seed color.ARGB) *CorePalette {
+	hct := seed.ToHct()
+	return newCorePalette(hct.Hue, hct.Chroma, true)
+}
+
+func newCorePalette(hue, chroma float64, content bool) *CorePalette {
+	tertiaryHue := num.NormalizeDegree(hue + 60)
+	if content {
+		return &CorePalette{
+			Primary:        FromHueAndChroma(hue, chroma),
+			Secondary:      FromHueAndChroma(hue, chroma/3),
+			Tertiary:       FromHueAndChroma(tertiaryHue, chroma/2),
+			Neutral:        FromHueAndChroma(hue, min(chroma/12, 4)),
+			NeutralVariant: FromHueAndChroma(hue, min(chroma/6, 8)),
+			Error:          FromHueAndChroma(25, 84),
+		}
+	}
+	return &CorePalette{
+		Primary:        FromHueAndChroma(hue, math.Max(48, chroma)),
+		Secondary:      FromHueAndChroma(hue, 16),
+		Tertiary:       FromHueAndChroma(tertiaryHue, 24),
+		Neutral:        FromHueAndChroma(hue, 4),
+		NeutralVariant: FromHueAndChroma(hue, 8),
+		Error:          FromHueAndChroma(25, 84),
+	}
+}
@@ -0,0 +1,50 @@
+package palettes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+)
+
+func TestCorePaletteOf(t *testing.T) {
+	seed := color.ARGB(0xFF0000FF)
+	hct := seed.ToHct()
+
+	tests := []struct {
+		name    string
+		palette *CorePalette
+		chroma  [6]float64
+	}{
+		{"Standard", CorePaletteOf(seed), [6]float64{hct.Chroma, 16, 24, 4, 8, 84}},
+		{"Content", ContentCorePaletteOf(seed), [6]float64{
+			hct.Chroma, hct.Chroma / 3, hct.Chroma / 2, 4, 8, 84,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.palette
+			for i, tp := range []*TonalPalette{
+				p.Primary, p.Secondary, p.Tertiary, p.Neutral, p.NeutralVariant, p.Error,
+			} {
+				// Key colors can not always reach requested chroma
+				if tp.Chroma > tt.chroma[i]+0.5 {
+					t.Errorf("palette %d chroma = %v, want <= %v", i, tp.Chroma, tt.chroma[i])
+				}
+			}
+			if math.Abs(p.Primary.Hue-hct.Hue) > 1 {
+				t.Errorf("Primary.Hue = %v, want %v", p.Primary.Hue, hct.Hue)
+			}
+			if math.Abs(p.Tertiary.Hue-math.Mod(hct.Hue+60, 360)) > 1 {
+				t.Errorf("Tertiary.Hue = %v, want %v", p.Tertiary.Hue, hct.Hue+60)
+			}
+		})
+	}
+
+	// Low chroma seeds still get a colorful primary palette
+	gray := CorePaletteOf(0xFF777777)
+	if gray.Primary.KeyColor.Chroma < 40 {
+		t.Errorf("CorePaletteOf(gray).Primary chroma = %v, want >= 40", gray.Primary.KeyColor.Chroma)
+	}
+}
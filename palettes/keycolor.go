@@ -6,7 +6,8 @@ import (
 	"github.com/Nadim147c/material/color"
 )
 
-// KeyColor is a color that represents the hue and chroma of a tonal palette
+// KeyColor finds the color that represents the hue and chroma of a tonal
+// palette. The resulting key color is exposed as TonalPalette.KeyColor.
 type KeyColor struct {
 	// hue is the hue of the key color
 	hue float64
@@ -28,8 +29,7 @@ func NewKeyColor(hue, requestedChroma float64) *KeyColor {
 	}
 }
 
-// maxChroma calculates the maximum chroma for a given tone
-// This is a placeholder for the actual implementation
+// maxChroma returns the maximum chroma available for hue at tone.
 func (k *KeyColor) maxChroma(tone float64) float64 {
 	if chroma, exists := k.chromaCache[tone]; exists {
 		return chroma
@@ -0,0 +1,42 @@
+package palettes
+
+import (
+	"math"
+	"testing"
+)
+
+func TestKeyColor_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		hue       float64
+		chroma    float64
+		minChroma float64
+		wantTone  float64
+		checkTone bool
+	}{
+		// Exact chroma is available near tone 50
+		{"Exact chroma", 50, 60, 59.5, 0, false},
+		// Requested chroma exceeds the gamut, the maximum is used
+		{"High chroma", 149, 200, 89, 0, false},
+		// Low chroma is always available at tone 50
+		{"Low chroma", 50, 3, 2.5, 50, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := FromHueAndChroma(tt.hue, tt.chroma).KeyColor
+			if math.Abs(key.Hue-tt.hue) > 10 {
+				t.Errorf("KeyColor.Hue = %v, want %v", key.Hue, tt.hue)
+			}
+			if key.Chroma < tt.minChroma {
+				t.Errorf("KeyColor.Chroma = %v, want >= %v", key.Chroma, tt.minChroma)
+			}
+			if key.Tone <= 0 || key.Tone >= 100 {
+				t.Errorf("KeyColor.Tone = %v, want in (0, 100)", key.Tone)
+			}
+			if tt.checkTone && math.Abs(key.Tone-tt.wantTone) > 0.5 {
+				t.Errorf("KeyColor.Tone = %v, want %v", key.Tone, tt.wantTone)
+			}
+		})
+	}
+}
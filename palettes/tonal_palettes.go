@@ -1,53 +1,139 @@
 package palettes
 
-import "github.com/Nadim147c/material/color"
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"iter"
+	"math"
+	"sync"
 
+	"github.com/Nadim147c/material/color"
+)
+
+// StandardTones are the tones of a TonalPalette used by Material Design.
+var StandardTones = []float64{0, 5, 10, 15, 20, 25, 30, 35, 40, 50, 60, 70, 80, 90, 95, 98, 99, 100}
+
+// surfaceTones are the extra tones used by surface roles of Material 3.
+var surfaceTones = []float64{4, 6, 12, 17, 22, 24, 87, 92, 94, 96}
+
+// toneKey is a tone of the palette with the hue and chroma it was solved with.
+type toneKey struct {
+	hue, chroma, tone float64
+}
+
+// toneCache holds solved tones of a TonalPalette. It is shared by copies of
+// the palette, tones are keyed by hue and chroma so a copy or a decoded palette
+// with other hue or chroma never sees colors of the original.
+type toneCache struct {
+	mu    sync.RWMutex
+	tones map[toneKey]color.ARGB
+}
+
+func newToneCache() *toneCache {
+	return &toneCache{tones: make(map[toneKey]color.ARGB)}
+}
+
+// TonalPalette is a set of colors sharing hue and chroma of a key color that
+// only differ in tone. Palettes created with the constructors of this package
+// or decoded with UnmarshalJSON or UnmarshalBinary cache solved tones and are
+// safe for concurrent use.
 type TonalPalette struct {
-	cache    map[float64]color.ARGB
-	Hue      float64
-	Chroma   float64
-	KeyColor color.Hct
+	cache    *toneCache
+	Hue      float64   `json:"hue"       yaml:"hue"       toml:"hue"`
+	Chroma   float64   `json:"chroma"    yaml:"chroma"    toml:"chroma"`
+	KeyColor color.Hct `json:"key_color" yaml:"key_color" toml:"key_color"`
 }
 
+// NewFromARGB creates a TonalPalette from hue and chroma of color.
 func NewFromARGB(color color.ARGB) *TonalPalette {
 	hct := color.ToHct()
 	return NewFromHct(hct)
 }
 
+// NewFromHct creates a TonalPalette from hue and chroma of hct. hct is used as
+// the key color.
 func NewFromHct(hct color.Hct) *TonalPalette {
 	return &TonalPalette{
+		cache:    newToneCache(),
 		Hue:      hct.Hue,
 		Chroma:   hct.Chroma,
 		KeyColor: hct,
 	}
 }
 
+// FromHueAndChroma creates a TonalPalette from hue and chroma. Tones use the
+// exact hue and chroma, even if the key color can not reach the chroma. The
+// key color is the color with given hue and chroma closest to tone 50.
 func FromHueAndChroma(hue, chroma float64) *TonalPalette {
-	keyColor := NewKeyColor(hue, chroma).Create()
-	return NewFromHct(keyColor)
+	return &TonalPalette{
+		cache:    newToneCache(),
+		Hue:      hue,
+		Chroma:   chroma,
+		KeyColor: NewKeyColor(hue, chroma).Create(),
+	}
 }
 
+// Tone returns the color of the palette at tone in range [0, 100].
 func (tp *TonalPalette) Tone(tone float64) color.ARGB {
 	if tp.cache == nil {
-		tp.cache = make(map[float64]color.ARGB)
+		return color.NewHct(tp.Hue, tp.Chroma, tone).ToARGB()
 	}
 
-	argb, ok := tp.cache[tone]
-	if !ok {
-		argb = color.NewHct(tp.Hue, tp.Chroma, tone).ToARGB()
-		tp.cache[tone] = argb
+	key := toneKey{tp.Hue, tp.Chroma, tone}
+	tp.cache.mu.RLock()
+	argb, ok := tp.cache.tones[key]
+	tp.cache.mu.RUnlock()
+	if ok {
+		return argb
 	}
+
+	// Solve outside of the lock, concurrent callers may solve the same tone
+	// but the result is identical.
+	argb = color.NewHct(tp.Hue, tp.Chroma, tone).ToARGB()
+
+	tp.cache.mu.Lock()
+	tp.cache.tones[key] = argb
+	tp.cache.mu.Unlock()
 	return argb
 }
 
+// PrecomputeCommonTones solves and caches StandardTones and the tones used by
+// surface roles, so that later calls to Tone for them are cheap.
+func (tp *TonalPalette) PrecomputeCommonTones() {
+	for _, tone := range StandardTones {
+		tp.Tone(tone)
+	}
+	for _, tone := range surfaceTones {
+		tp.Tone(tone)
+	}
+}
+
+// Get is an alias of Tone.
 func (tp *TonalPalette) Get(tone float64) color.ARGB {
 	return tp.Tone(tone)
 }
 
+// GetHct returns the color of the palette at tone as Hct.
 func (tp *TonalPalette) GetHct(tone float64) color.Hct {
 	return tp.Tone(tone).ToHct()
 }
 
+// Tones returns an iterator over tone and color pairs of the palette. If no
+// tones are given, StandardTones are used.
+func (tp *TonalPalette) Tones(tones ...float64) iter.Seq2[float64, color.ARGB] {
+	if len(tones) == 0 {
+		tones = StandardTones
+	}
+	return func(yield func(float64, color.ARGB) bool) {
+		for _, tone := range tones {
+			if !yield(tone, tp.Tone(tone)) {
+				return
+			}
+		}
+	}
+}
+
 // IsBlue determines if a hue is in the blue range.
 func (tp *TonalPalette) IsBlue() bool {
 	return tp.Hue >= 250 && tp.Hue < 270
@@ -62,3 +148,40 @@ func (tp *TonalPalette) IsYellow() bool {
 func (tp *TonalPalette) IsCyan() bool {
 	return tp.Hue >= 170 && tp.Hue < 207
 }
+
+// UnmarshalJSON implements json.Unmarshaler. The decoded palette gets a new
+// tone cache.
+func (tp *TonalPalette) UnmarshalJSON(data []byte) error {
+	type palette TonalPalette
+	if err := json.Unmarshal(data, (*palette)(tp)); err != nil {
+		return err
+	}
+	tp.cache = newToneCache()
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. Hue and chroma are
+// encoded as big endian float64 values followed by binary form of KeyColor,
+// 40 bytes in total. Cached tones are not encoded.
+func (tp *TonalPalette) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, 40)
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(tp.Hue))
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(tp.Chroma))
+	return tp.KeyColor.AppendBinary(b)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (tp *TonalPalette) UnmarshalBinary(data []byte) error {
+	if len(data) != 40 {
+		return errors.New("invalid binary tonal palette data")
+	}
+	var key color.Hct
+	if err := key.UnmarshalBinary(data[16:]); err != nil {
+		return err
+	}
+	tp.Hue = math.Float64frombits(binary.BigEndian.Uint64(data[0:8]))
+	tp.Chroma = math.Float64frombits(binary.BigEndian.Uint64(data[8:16]))
+	tp.KeyColor = key
+	tp.cache = newToneCache()
+	return nil
+}
@@ -0,0 +1,119 @@
+package palettes
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+)
+
+func TestTonalPalette_MarshalBinary(t *testing.T) {
+	want := NewFromARGB(color.ARGB(0xFF6750A4))
+	want.Tone(40) // populate cache
+
+	data, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+
+	var got TonalPalette
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() error = %v", err)
+	}
+	if got.Hue != want.Hue || got.Chroma != want.Chroma || got.KeyColor != want.KeyColor {
+		t.Errorf("UnmarshalBinary() = %+v, want %+v", got, want)
+	}
+	if got.Tone(40) != want.Tone(40) {
+		t.Errorf("Tone(40) = %s, want %s", got.Tone(40).HexRGB(), want.Tone(40).HexRGB())
+	}
+
+	if err := got.UnmarshalBinary(data[:10]); err == nil {
+		t.Errorf("UnmarshalBinary(short) error = nil, want error")
+	}
+}
+
+func TestTonalPalette_Tones(t *testing.T) {
+	tp := FromHueAndChroma(270, 36)
+
+	var tones []float64
+	for tone, c := range tp.Tones() {
+		tones = append(tones, tone)
+		if c != tp.Tone(tone) {
+			t.Errorf("Tones() %v = %s, want %s", tone, c.HexRGB(), tp.Tone(tone).HexRGB())
+		}
+	}
+	if len(tones) != len(StandardTones) {
+		t.Errorf("len(Tones()) = %d, want %d", len(tones), len(StandardTones))
+	}
+
+	for tone, c := range tp.Tones(0, 100) {
+		if tone == 0 && c != 0xFF000000 || tone == 100 && c != 0xFFFFFFFF {
+			t.Errorf("Tones(0, 100) %v = %s", tone, c.HexRGB())
+		}
+		break
+	}
+}
+
+func TestTonalPalette_ConcurrentTone(t *testing.T) {
+	tp := NewFromARGB(0xFF0000FF)
+	want := color.NewHct(tp.Hue, tp.Chroma, 40).ToARGB()
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, tone := range StandardTones {
+				tp.Tone(tone)
+			}
+			if got := tp.Tone(40); got != want {
+				t.Errorf("Tone(40) = %s, want %s", got.HexRGB(), want.HexRGB())
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestTonalPalette_PrecomputeCommonTones(t *testing.T) {
+	tp := FromHueAndChroma(270, 36)
+	tp.PrecomputeCommonTones()
+
+	if got, want := len(tp.cache.tones), len(StandardTones)+len(surfaceTones); got != want {
+		t.Errorf("cached %d tones, want %d", got, want)
+	}
+
+	// Palettes without cache still solve tones
+	literal := &TonalPalette{Hue: tp.Hue, Chroma: tp.Chroma}
+	if got, want := literal.Tone(87), tp.Tone(87); got != want {
+		t.Errorf("Tone(87) without cache = %s, want %s", got.HexRGB(), want.HexRGB())
+	}
+}
+
+func TestTonalPalette_CacheFollowsHue(t *testing.T) {
+	tp := FromHueAndChroma(20, 48)
+	tp.PrecomputeCommonTones()
+
+	data, err := json.Marshal(FromHueAndChroma(200, 48))
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if err := json.Unmarshal(data, tp); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	want := color.NewHct(200, 48, 40).ToARGB()
+	if got := tp.Tone(40); got != want {
+		t.Errorf("Tone(40) after decoding = %s, want %s", got.HexRGB(), want.HexRGB())
+	}
+	if tp.cache == nil {
+		t.Error("decoded palette has no tone cache")
+	}
+
+	// A copy shares the cache but not the colors of another hue
+	c := *tp
+	c.Hue = 250
+	want = color.NewHct(250, 48, 40).ToARGB()
+	if got := c.Tone(40); got != want {
+		t.Errorf("Tone(40) of copy = %s, want %s", got.HexRGB(), want.HexRGB())
+	}
+}
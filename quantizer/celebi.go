@@ -1,7 +1,13 @@
 package quantizer
 
+// QuantizeCelebi reduces input to at most maxColor colors and returns them
+// mapped to their population. It is the recommended quantizer for extracting
+// theme colors from images.
+//
+// Wu quantization gives a fast and stable first guess, which is then refined
+// with weighted square means starting from the Wu clusters.
 func QuantizeCelebi(input pixels, maxColor int) QuantizedMap {
-	wu := QuantizeWu(input, maxColor*2)
+	wu := QuantizeWu(input, maxColor)
 	colors := make(pixelsLab, len(wu))
 	for i, c := range wu {
 		colors[i] = c.ToLab()
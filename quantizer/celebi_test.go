@@ -32,8 +32,22 @@ func TestQuantizeCelebi(t *testing.T) {
 	}
 
 	result := QuantizeCelebi(pixels, 5)
+	if len(result) == 0 || len(result) > 5 {
+		t.Fatalf("QuantizeCelebi() returned %d clusters, want 1 to 5", len(result))
+	}
 
 	for color, count := range result {
 		t.Logf("Cluster %s %s: %d", color.HexRGB(), color.AnsiBg("  "), count)
 	}
 }
+
+func TestQuantizeCelebiSolidColors(t *testing.T) {
+	red := color.ARGB(0xffff0000)
+	blue := color.ARGB(0xff0000ff)
+	input := pixels{red, red, red, blue}
+
+	result := QuantizeCelebi(input, 128)
+	if len(result) != 2 || result[red] != 3 || result[blue] != 1 {
+		t.Errorf("QuantizeCelebi() = %v, want map[%v:3 %v:1]", result, red, blue)
+	}
+}
@@ -2,7 +2,9 @@ package quantizer
 
 import "slices"
 
-// QuantizeMap takes a slice of []color.Color and returns Quantized
+// QuantizeMap counts every opaque color of input without reducing them. It is
+// useful for small or already paletted images, and as histogram for the other
+// quantizers. Pixels with any transparency are ignored.
 func QuantizeMap(input pixels) QuantizedMap {
 	colors := make(QuantizedMap)
 	for pixel := range slices.Values(input) {
@@ -0,0 +1,32 @@
+package quantizer
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+)
+
+func TestQuantizeMap(t *testing.T) {
+	red := color.ARGB(0xffff0000)
+	green := color.ARGB(0xff00ff00)
+
+	tests := []struct {
+		name  string
+		input pixels
+		want  QuantizedMap
+	}{
+		{"empty", pixels{}, QuantizedMap{}},
+		{"1 red", pixels{red}, QuantizedMap{red: 1}},
+		{"2 red 3 green", pixels{red, green, red, green, green}, QuantizedMap{red: 2, green: 3}},
+		{"skips transparent", pixels{red, 0x80ff0000, 0x00000000}, QuantizedMap{red: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := QuantizeMap(tt.input); !maps.Equal(got, tt.want) {
+				t.Errorf("QuantizeMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
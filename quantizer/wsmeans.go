@@ -26,75 +26,77 @@ type (
 	QuantizedMap = map[color.ARGB]int
 )
 
+// QuantizeWsMeans clusters input with weighted square means, k-means in L*a*b*
+// where every unique color is weighted by its population. Clusters start at
+// startingClusters, or at random positions if it is empty. The result maps the
+// color of every non-empty cluster to the number of pixels in it.
+//
+// Distances between clusters are computed once per iteration, which allows
+// skipping clusters that can not be closer to a point than its current cluster
+// by triangle inequality.
 func QuantizeWsMeans(input pixels, startingClusters []color.Lab, maxColors int) QuantizedMap {
-	// Get color frequncies
+	// Keep the random seed fixed so that results are reproducible
+	random := rand.New(rand.NewSource(0x42688))
+
+	// Get color frequencies, unique colors are kept in order of appearance
 	freq := make(map[color.ARGB]int)
+	unique := make(pixels, 0)
 	for c := range slices.Values(input) {
+		if freq[c] == 0 {
+			unique = append(unique, c)
+		}
 		freq[c]++
 	}
 
-	// Number of unique color in the image/pixels array
-	pointCount := len(freq)
-	points := make(pixelsLab, pointCount)
-	counts := make([]int, pointCount)
-	i := 0
-	for k, v := range freq {
-		points[i] = k.ToLab()
-		counts[i] = v
-		i++
+	points := make(pixelsLab, len(unique))
+	counts := make([]int, len(unique))
+	for i, c := range unique {
+		points[i] = c.ToLab()
+		counts[i] = freq[c]
 	}
 
+	// Number of unique color in the image/pixels array
+	pointCount := len(points)
+
 	clusterCount := min(maxColors, pointCount)
 	if len(startingClusters) != 0 {
 		clusterCount = min(clusterCount, len(startingClusters))
 	}
-
-	clusters := make([]color.Lab, 0)
-
-	for cluster := range slices.Values(startingClusters) {
-		clusters = append(clusters, cluster)
+	if clusterCount <= 0 {
+		return QuantizedMap{}
 	}
 
+	clusters := slices.Clone(startingClusters)
 	clustersNeeded := clusterCount - len(clusters)
 	if len(startingClusters) == 0 && clustersNeeded > 0 {
-		clusters = append(clusters, randomLabClusters(clustersNeeded)...)
+		clusters = append(clusters, randomLabClusters(random, clustersNeeded)...)
 	}
 
 	clusterIndices := make([]int, pointCount)
 	for i := range clusterIndices {
-		clusterIndices[i] = rand.Intn(clusterCount)
-	}
-
-	indexMatrix := make([][]int, clusterCount)
-	for i := range indexMatrix {
-		indexMatrix[i] = make([]int, clusterCount)
+		clusterIndices[i] = random.Intn(clusterCount)
 	}
 
 	distanceToIndexMatrix := make([][]distanceAndIndex, clusterCount)
 	for i := range distanceToIndexMatrix {
-		distanceToIndexMatrix[i] = make([]distanceAndIndex, len(clusters))
+		distanceToIndexMatrix[i] = make([]distanceAndIndex, clusterCount)
 	}
 
+	pixelCountSums := make([]int, clusterCount)
 	for iteration := range MaxIterations {
-		// Step 1: Compute cluster-to-cluster distances
+		// Step 1: Compute cluster-to-cluster distances, nearest first
 		for i := range clusterCount {
 			for j := range clusterCount {
 				distance := clusters[i].DistanceSquared(clusters[j])
-				distanceToIndexMatrix[j][i].distance = distance
-				distanceToIndexMatrix[j][i].index = i
-				distanceToIndexMatrix[i][j].distance = distance
-				distanceToIndexMatrix[i][j].index = j
+				distanceToIndexMatrix[i][j] = distanceAndIndex{distance, j}
 			}
 
 			slices.SortFunc(distanceToIndexMatrix[i], func(a, b distanceAndIndex) int {
 				return num.SignCmp(a.distance, b.distance)
 			})
-
-			for j := range clusterCount {
-				indexMatrix[i][j] = distanceToIndexMatrix[i][j].index
-			}
 		}
 
+		// Step 2: Move every point to its nearest cluster
 		pointsMoved := 0
 		for i, point := range points {
 			previousClusterIndex := clusterIndices[i]
@@ -103,14 +105,16 @@ func QuantizeWsMeans(input pixels, startingClusters []color.Lab, maxColors int)
 			minimumDistance := previousDistance
 			newClusterIndex := -1
 
-			for j := range clusterCount {
-				if distanceToIndexMatrix[previousClusterIndex][j].distance >= 4*previousDistance {
-					continue
+			for other := range slices.Values(distanceToIndexMatrix[previousClusterIndex]) {
+				// Clusters this far from the current one can't be nearer to
+				// the point.
+				if other.distance >= 4*previousDistance {
+					break
 				}
-				distance := point.DistanceSquared(clusters[j])
+				distance := point.DistanceSquared(clusters[other.index])
 				if distance < minimumDistance {
 					minimumDistance = distance
-					newClusterIndex = j
+					newClusterIndex = other.index
 				}
 			}
 
@@ -127,26 +131,25 @@ func QuantizeWsMeans(input pixels, startingClusters []color.Lab, maxColors int)
 			break
 		}
 
+		// Step 3: Move clusters to the weighted mean of their points
 		component0Sums := make([]float64, clusterCount) // L
 		component1Sums := make([]float64, clusterCount) // a
 		component2Sums := make([]float64, clusterCount) // b
-		pixelCountSums := make([]float64, clusterCount)
+		clear(pixelCountSums)
 
-		// Accumulate weighted components
 		for i := range pointCount {
 			clusterIndex := clusterIndices[i]
 			point := points[i]
-			count := float64(counts[i])
+			count := counts[i]
 
 			pixelCountSums[clusterIndex] += count
-			component0Sums[clusterIndex] += point.L * count
-			component1Sums[clusterIndex] += point.A * count
-			component2Sums[clusterIndex] += point.B * count
+			component0Sums[clusterIndex] += point.L * float64(count)
+			component1Sums[clusterIndex] += point.A * float64(count)
+			component2Sums[clusterIndex] += point.B * float64(count)
 		}
 
-		// Compute new cluster centers
 		for i := range clusterCount {
-			count := pixelCountSums[i]
+			count := float64(pixelCountSums[i])
 			if count == 0 {
 				clusters[i] = color.NewLab(0.0, 0.0, 0.0)
 				continue
@@ -156,40 +159,32 @@ func QuantizeWsMeans(input pixels, startingClusters []color.Lab, maxColors int)
 			b := component2Sums[i] / count
 			clusters[i] = color.NewLab(l, a, b)
 		}
+	}
 
-		argbToPopulation := make(QuantizedMap)
-
-		for i := range clusterCount {
-			count := int(pixelCountSums[i])
-			if count == 0 {
-				continue
-			}
-
-			colorInt := clusters[i].ToARGB()
-			if _, exists := argbToPopulation[colorInt]; exists {
-				continue
-			}
-
-			argbToPopulation[colorInt] = count
+	argbToPopulation := make(QuantizedMap)
+	for i := range clusterCount {
+		count := pixelCountSums[i]
+		if count == 0 {
+			continue
 		}
 
-		return argbToPopulation
+		argb := clusters[i].ToARGB()
+		if _, exists := argbToPopulation[argb]; exists {
+			continue
+		}
 
+		argbToPopulation[argb] = count
 	}
 
-	result := make(QuantizedMap)
-	for lab := range slices.Values(clusters) {
-		result[lab.ToARGB()]++
-	}
-	return result
+	return argbToPopulation
 }
 
-func randomLabClusters(n int) []color.Lab {
+func randomLabClusters(random *rand.Rand, n int) []color.Lab {
 	clusters := make([]color.Lab, n)
 	for i := range n {
-		l := rand.Float64() * 100.0
-		a := rand.Float64()*200.0 - 100.0
-		b := rand.Float64()*200.0 - 100.0
+		l := random.Float64() * 100.0
+		a := random.Float64()*200.0 - 100.0
+		b := random.Float64()*200.0 - 100.0
 		clusters[i] = color.NewLab(l, a, b)
 	}
 	return clusters
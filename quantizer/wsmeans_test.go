@@ -2,6 +2,7 @@ package quantizer
 
 import (
 	"image/jpeg"
+	"maps"
 	"os"
 	"testing"
 
@@ -9,6 +10,34 @@ import (
 )
 
 func TestQuantizeWsMeans(t *testing.T) {
+	red := color.ARGB(0xffff0000)
+	green := color.ARGB(0xff00ff00)
+	blue := color.ARGB(0xff0000ff)
+
+	tests := []struct {
+		name      string
+		input     pixels
+		clusters  []color.Lab
+		maxColors int
+		want      QuantizedMap
+	}{
+		{"1 red", pixels{red}, nil, 128, QuantizedMap{red: 1}},
+		{"2 red 3 green", pixels{red, red, green, green, green}, []color.Lab{red.ToLab(), green.ToLab()}, 128, QuantizedMap{red: 2, green: 3}},
+		{"1 red 1 green 1 blue", pixels{red, green, blue}, []color.Lab{red.ToLab(), green.ToLab(), blue.ToLab()}, 128, QuantizedMap{red: 1, green: 1, blue: 1}},
+		{"no colors", pixels{}, nil, 128, QuantizedMap{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := QuantizeWsMeans(tt.input, tt.clusters, tt.maxColors)
+			if !maps.Equal(result, tt.want) {
+				t.Errorf("QuantizeWsMeans() = %v, want %v", result, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuantizeWsMeansImage(t *testing.T) {
 	// Load the test image
 	file, err := os.Open("./gophar.jpg")
 	if err != nil {
@@ -32,8 +61,20 @@ func TestQuantizeWsMeans(t *testing.T) {
 	}
 
 	result := QuantizeWsMeans(pixels, nil, 3)
+	if len(result) == 0 || len(result) > 3 {
+		t.Fatalf("QuantizeWsMeans() returned %d clusters, want 1 to 3", len(result))
+	}
 
+	total := 0
 	for color, count := range result {
 		t.Logf("Cluster %s %s: %d", color.HexRGB(), color.AnsiBg("  "), count)
+		total += count
+	}
+	if total > len(pixels) {
+		t.Errorf("QuantizeWsMeans() population %d exceeds pixel count %d", total, len(pixels))
+	}
+
+	if again := QuantizeWsMeans(pixels, nil, 3); !maps.Equal(again, result) {
+		t.Errorf("QuantizeWsMeans() is not deterministic: %v != %v", again, result)
 	}
 }
@@ -1,15 +1,16 @@
 package quantizer
 
 import (
+	"math"
+
 	"github.com/Nadim147c/material/color"
 )
 
 const (
 	indexBits    int64 = 5
 	bitsToRemove int64 = 8 - indexBits
-	histSize     int64 = 32  // 32 bins
-	cubeSize     int64 = 33  // 32 bins + 1 for cumulative indexing
-	maxColors    int64 = 256 // or any desired palette size
+	histSize     int64 = 32 // 32 bins
+	cubeSize     int64 = 33 // 32 bins + 1 for cumulative indexing
 	totalSize    int64 = 35937
 )
 
@@ -32,19 +33,9 @@ type box struct {
 	vol    float64
 }
 
-type colorCube struct {
-	Boxes [maxColors]box
-	Next  int64 // how many boxes created so far
-}
-
 type maximizeResult struct {
 	cutLocation int64
-	maximum     int64
-}
-
-type createBoxesResult struct {
-	MaxColors           int
-	GeneratedColorCount int
+	maximum     float64
 }
 
 type quantizerWu struct {
@@ -56,7 +47,15 @@ type quantizerWu struct {
 	cubes    []box
 }
 
+// QuantizeWu reduces input to at most maxColor colors using Xiaolin Wu's
+// greedy box cutting algorithm. The RGB cube is split into boxes along the axis
+// that minimizes variance of color moments, and the average color of each box
+// is returned. Transparent pixels are ignored.
 func QuantizeWu(input pixels, maxColor int) pixels {
+	if maxColor <= 0 {
+		return pixels{}
+	}
+
 	q := quantizerWu{
 		weights:  make([]int64, totalSize),
 		momentsR: make([]int64, totalSize),
@@ -102,9 +101,9 @@ func (q *quantizerWu) CreateResult(maxColor int) pixels {
 		cube := q.cubes[i]
 		weight := q.Volume(&cube, q.weights)
 		if weight > 0 {
-			r := uint32(q.Volume(&cube, q.momentsR) / weight)
-			g := uint32(q.Volume(&cube, q.momentsG) / weight)
-			b := uint32(q.Volume(&cube, q.momentsB) / weight)
+			r := uint32(math.Round(float64(q.Volume(&cube, q.momentsR)) / float64(weight)))
+			g := uint32(math.Round(float64(q.Volume(&cube, q.momentsG)) / float64(weight)))
+			b := uint32(math.Round(float64(q.Volume(&cube, q.momentsB)) / float64(weight)))
 			color := color.ARGB((255 << 24) | ((r & 0x0FF) << 16) | ((g & 0x0FF) << 8) | (b & 0x0FF))
 			colors = append(colors, color)
 		}
@@ -114,7 +113,7 @@ func (q *quantizerWu) CreateResult(maxColor int) pixels {
 
 func (q *quantizerWu) CreateBoxes(maxColors int) int {
 	q.cubes = make([]box, maxColors)
-	volumeVariance := make([]int64, maxColors)
+	volumeVariance := make([]float64, maxColors)
 
 	q.cubes[0] = box{
 		r0: 0, g0: 0, b0: 0,
@@ -155,13 +154,14 @@ func (q *quantizerWu) CreateBoxes(maxColors int) int {
 }
 
 func (q *quantizerWu) ComputeMoments() {
-	area := make([]int64, cubeSize)
-	areaR := make([]int64, cubeSize)
-	areaG := make([]int64, cubeSize)
-	areaB := make([]int64, cubeSize)
-	area2 := make([]int64, cubeSize)
-
 	for r := int64(1); r < cubeSize; r++ {
+		// Areas accumulate over a single red plane
+		area := make([]int64, cubeSize)
+		areaR := make([]int64, cubeSize)
+		areaG := make([]int64, cubeSize)
+		areaB := make([]int64, cubeSize)
+		area2 := make([]int64, cubeSize)
+
 		for g := int64(1); g < cubeSize; g++ {
 			var line, line2, lineR, lineG, lineB int64
 			for b := int64(1); b < cubeSize; b++ {
@@ -229,19 +229,16 @@ func (q *quantizerWu) Cut(one *box, two *box) bool {
 		two.r0 = one.r1
 		two.g0 = one.g0
 		two.b0 = one.b0
-		break
 	case directionGreen:
 		one.g1 = maxGResult.cutLocation
 		two.r0 = one.r0
 		two.g0 = one.g1
 		two.b0 = one.b0
-		break
 	case directionBlue:
 		one.b1 = maxBResult.cutLocation
 		two.r0 = one.r0
 		two.g0 = one.g0
 		two.b0 = one.b1
-		break
 	default:
 		panic("unexpected direction")
 	}
@@ -251,7 +248,7 @@ func (q *quantizerWu) Cut(one *box, two *box) bool {
 	return true
 }
 
-func (q *quantizerWu) Variance(cube *box) int64 {
+func (q *quantizerWu) Variance(cube *box) float64 {
 	dr := q.Volume(cube, q.momentsR)
 	dg := q.Volume(cube, q.momentsG)
 	db := q.Volume(cube, q.momentsB)
@@ -263,9 +260,9 @@ func (q *quantizerWu) Variance(cube *box) int64 {
 		q.moments[index(cube.r0, cube.g1, cube.b0)] +
 		q.moments[index(cube.r0, cube.g0, cube.b1)] -
 		q.moments[index(cube.r0, cube.g0, cube.b0)]
-	hypotenuse := dr*dr + dg*dg + db*db
-	volume := q.Volume(cube, q.weights)
-	return xx - hypotenuse/volume
+	hypotenuse := float64(dr*dr + dg*dg + db*db)
+	volume := float64(q.Volume(cube, q.weights))
+	return float64(xx) - hypotenuse/volume
 }
 
 func (q *quantizerWu) bottom(cube *box, direction direction, moment []int64) int64 {
@@ -321,7 +318,7 @@ func (q *quantizerWu) Maximize(
 	bottomB := q.bottom(cube, direction, q.momentsB)
 	bottomW := q.bottom(cube, direction, q.weights)
 
-	var maxVal int64
+	var maxVal float64
 	var cut int64 = -1
 
 	var halfR, halfG, halfB, halfW int64
@@ -334,7 +331,7 @@ func (q *quantizerWu) Maximize(
 			continue
 		}
 
-		temp := (halfR*halfR + halfG*halfG + halfB*halfB) / halfW
+		temp := float64(halfR*halfR+halfG*halfG+halfB*halfB) / float64(halfW)
 
 		halfR = wholeR - halfR
 		halfG = wholeG - halfG
@@ -344,7 +341,7 @@ func (q *quantizerWu) Maximize(
 			continue
 		}
 
-		temp += (halfR*halfR + halfG*halfG + halfB*halfB) / halfW
+		temp += float64(halfR*halfR+halfG*halfG+halfB*halfB) / float64(halfW)
 
 		if temp > maxVal {
 			maxVal = temp
@@ -5,6 +5,9 @@ import (
 	"github.com/Nadim147c/material/dynamic"
 )
 
+// NewContent creates a scheme for colors extracted from content like images.
+// Like NewFidelity it keeps the chroma of the source color, but the tertiary
+// palette is an analogous color from the temperature cache.
 func NewContent(
 	sourceColor color.Hct,
 	isDark bool,
@@ -0,0 +1,26 @@
+package schemes
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/dislike"
+	"github.com/Nadim147c/material/dynamic"
+	"github.com/Nadim147c/material/temperature"
+)
+
+func TestContentTertiary(t *testing.T) {
+	for _, argb := range []color.ARGB{0xFF0000FF, 0xFFB3261E, 0xFF6750A4, 0xFF386A20} {
+		source := argb.ToHct()
+		scheme := NewContent(source, false, 0, dynamic.Phone, dynamic.V2021)
+
+		analogous := temperature.NewTemperatureCache(source).Analogous(3, 6)
+		want := dislike.FixIfDisliked(analogous[2])
+		if got := scheme.TertiaryPalette.KeyColor; got != want {
+			t.Errorf("%s: tertiary key color = %v, want %v", argb.HexRGB(), got, want)
+		}
+		if got := scheme.PrimaryContainer(); got != argb {
+			t.Errorf("%s: PrimaryContainer() = %s, want source color", argb.HexRGB(), got.HexRGB())
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package schemes
+
+import (
+	"github.com/Nadim147c/material/blend"
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/palettes"
+)
+
+// CustomColor is a color like a brand color that is added to a scheme.
+type CustomColor struct {
+	// Name is the name of the color, e.g. "brand"
+	Name string `json:"name"  yaml:"name"  toml:"name"`
+	// Value is the color
+	Value color.ARGB `json:"value" yaml:"value" toml:"value"`
+	// Blend harmonizes Value with the source color of the scheme when true
+	Blend bool `json:"blend" yaml:"blend" toml:"blend"`
+}
+
+// ColorGroup is the set of roles of a custom color in a scheme.
+type ColorGroup struct {
+	Color            color.ARGB `json:"color"              yaml:"color"              toml:"color"`
+	OnColor          color.ARGB `json:"on_color"           yaml:"on_color"           toml:"on_color"`
+	ColorContainer   color.ARGB `json:"color_container"    yaml:"color_container"    toml:"color_container"`
+	OnColorContainer color.ARGB `json:"on_color_container" yaml:"on_color_container" toml:"on_color_container"`
+}
+
+// CustomColorGroup is a custom color resolved for light and dark schemes.
+type CustomColorGroup struct {
+	// Color is the requested custom color
+	Color CustomColor `json:"color" yaml:"color" toml:"color"`
+	// Value is the harmonized value of the custom color, or the original value
+	// if Color.Blend is false
+	Value color.ARGB `json:"value" yaml:"value" toml:"value"`
+	Light ColorGroup `json:"light" yaml:"light" toml:"light"`
+	Dark  ColorGroup `json:"dark"  yaml:"dark"  toml:"dark"`
+}
+
+// NewCustomColorGroup resolves c for a scheme generated from source. If
+// c.Blend is true, the hue of c is shifted towards source with
+// blend.Harmonize.
+func NewCustomColorGroup(source color.ARGB, c CustomColor) CustomColorGroup {
+	value := c.Value
+	if c.Blend {
+		value = blend.Harmonize(value, source)
+	}
+
+	tones := palettes.CorePaletteOf(value).Primary
+	return CustomColorGroup{
+		Color: c,
+		Value: value,
+		Light: ColorGroup{
+			Color:            tones.Tone(40),
+			OnColor:          tones.Tone(100),
+			ColorContainer:   tones.Tone(90),
+			OnColorContainer: tones.Tone(10),
+		},
+		Dark: ColorGroup{
+			Color:            tones.Tone(80),
+			OnColor:          tones.Tone(20),
+			ColorContainer:   tones.Tone(30),
+			OnColorContainer: tones.Tone(90),
+		},
+	}
+}
+
+// Group returns the light or dark ColorGroup of g.
+func (g CustomColorGroup) Group(isDark bool) ColorGroup {
+	if isDark {
+		return g.Dark
+	}
+	return g.Light
+}
+
+// ToARGBMap returns the roles of g keyed by snake case role name, where color
+// is replaced by name, e.g. on_brand_container.
+func (g ColorGroup) ToARGBMap(name string) map[string]color.ARGB {
+	return map[string]color.ARGB{
+		name:                        g.Color,
+		"on_" + name:                g.OnColor,
+		name + "_container":         g.ColorContainer,
+		"on_" + name + "_container": g.OnColorContainer,
+	}
+}
@@ -0,0 +1,38 @@
+package schemes
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/contrast"
+)
+
+func TestCustomColorGroup(t *testing.T) {
+	source := color.ARGB(0xFF0000FF)
+	brand := CustomColor{Name: "brand", Value: 0xFFFF0000}
+
+	plain := NewCustomColorGroup(source, brand)
+	if plain.Value != brand.Value {
+		t.Errorf("Value = %s, want %s", plain.Value.HexRGB(), brand.Value.HexRGB())
+	}
+
+	brand.Blend = true
+	harmonized := NewCustomColorGroup(source, brand)
+	if want := color.ARGB(0xFFFB0057); harmonized.Value != want {
+		t.Errorf("harmonized Value = %s, want %s", harmonized.Value.HexRGB(), want.HexRGB())
+	}
+
+	for _, g := range []CustomColorGroup{plain, harmonized} {
+		if g.Light.OnColor != 0xFFFFFFFF {
+			t.Errorf("Light.OnColor = %s, want #FFFFFF", g.Light.OnColor.HexRGB())
+		}
+		light := contrast.RatioOfTones(g.Light.Color.ToHct().Tone, g.Light.OnColor.ToHct().Tone)
+		dark := contrast.RatioOfTones(g.Dark.Color.ToHct().Tone, g.Dark.OnColor.ToHct().Tone)
+		if light < 4.5 || dark < 4.5 {
+			t.Errorf("contrast of color and on color = %.2f, %.2f, want >= 4.5", light, dark)
+		}
+		if g.Group(true) != g.Dark || g.Group(false) != g.Light {
+			t.Errorf("Group() returned wrong group")
+		}
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"github.com/Nadim147c/material/dynamic"
 )
 
+// NewExpressive creates a playful scheme whose primary hue is rotated 240
+// degrees from the source hue, so the source color itself is not in the
+// scheme. Secondary and tertiary hues are rotated by piecewise tables.
 func NewExpressive(
 	sourceColor color.Hct,
 	isDark bool,
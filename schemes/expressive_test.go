@@ -0,0 +1,40 @@
+package schemes
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/dynamic"
+	"github.com/Nadim147c/material/num"
+)
+
+func TestExpressiveHueRotation(t *testing.T) {
+	tests := []struct {
+		hue       float64
+		secondary float64
+		tertiary  float64
+	}{
+		{10, 45, 120},
+		{30, 95, 120},
+		{100, 45, 20},
+		{130, 20, 45},
+		{170, 45, 20},
+		{220, 90, 15},
+		{282.7882, 45, 20},
+		{340, 45, 120},
+	}
+
+	for _, tt := range tests {
+		source := color.NewHct(tt.hue, 48, 50)
+		scheme := NewExpressive(source, false, 0, dynamic.Phone, dynamic.V2021)
+		if want := num.NormalizeDegree(source.Hue + 240); scheme.PrimaryPalette.Hue != want {
+			t.Errorf("hue %v: primary hue = %v, want %v", tt.hue, scheme.PrimaryPalette.Hue, want)
+		}
+		if want := num.NormalizeDegree(source.Hue + tt.secondary); scheme.SecondaryPalette.Hue != want {
+			t.Errorf("hue %v: secondary hue = %v, want %v", tt.hue, scheme.SecondaryPalette.Hue, want)
+		}
+		if want := num.NormalizeDegree(source.Hue + tt.tertiary); scheme.TertiaryPalette.Hue != want {
+			t.Errorf("hue %v: tertiary hue = %v, want %v", tt.hue, scheme.TertiaryPalette.Hue, want)
+		}
+	}
+}
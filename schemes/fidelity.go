@@ -5,6 +5,9 @@ import (
 	"github.com/Nadim147c/material/dynamic"
 )
 
+// NewFidelity creates a scheme that keeps the chroma of the source color. The
+// primary container is the source color itself and the tertiary palette is
+// the complement of the source color, fixed if it is disliked.
 func NewFidelity(
 	sourceColor color.Hct,
 	isDark bool,
@@ -0,0 +1,21 @@
+package schemes
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/dislike"
+	"github.com/Nadim147c/material/dynamic"
+)
+
+func TestFidelityKeepsSource(t *testing.T) {
+	for _, argb := range []color.ARGB{0xFF0000FF, 0xFFB3261E, 0xFF6750A4, 0xFF386A20} {
+		scheme := NewFidelity(argb.ToHct(), false, 0, dynamic.Phone, dynamic.V2021)
+		if got := scheme.PrimaryContainer(); got != argb {
+			t.Errorf("%s: PrimaryContainer() = %s, want source color", argb.HexRGB(), got.HexRGB())
+		}
+		if tertiary := scheme.TertiaryPalette.KeyColor; dislike.IsDisliked(tertiary) {
+			t.Errorf("%s: tertiary key color %v is disliked", argb.HexRGB(), tertiary)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package schemes
+
+import (
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/palettes"
+)
+
+// FixedRoles are the fixed color roles of Material 3. They have the same value
+// in light and dark scheme, which suits components that must not change with
+// the theme mode.
+type FixedRoles struct {
+	PrimaryFixed            color.ARGB `json:"primary_fixed"              yaml:"primary_fixed"              toml:"primary_fixed"`
+	PrimaryFixedDim         color.ARGB `json:"primary_fixed_dim"          yaml:"primary_fixed_dim"          toml:"primary_fixed_dim"`
+	OnPrimaryFixed          color.ARGB `json:"on_primary_fixed"           yaml:"on_primary_fixed"           toml:"on_primary_fixed"`
+	OnPrimaryFixedVariant   color.ARGB `json:"on_primary_fixed_variant"   yaml:"on_primary_fixed_variant"   toml:"on_primary_fixed_variant"`
+	SecondaryFixed          color.ARGB `json:"secondary_fixed"            yaml:"secondary_fixed"            toml:"secondary_fixed"`
+	SecondaryFixedDim       color.ARGB `json:"secondary_fixed_dim"        yaml:"secondary_fixed_dim"        toml:"secondary_fixed_dim"`
+	OnSecondaryFixed        color.ARGB `json:"on_secondary_fixed"         yaml:"on_secondary_fixed"         toml:"on_secondary_fixed"`
+	OnSecondaryFixedVariant color.ARGB `json:"on_secondary_fixed_variant" yaml:"on_secondary_fixed_variant" toml:"on_secondary_fixed_variant"`
+	TertiaryFixed           color.ARGB `json:"tertiary_fixed"             yaml:"tertiary_fixed"             toml:"tertiary_fixed"`
+	TertiaryFixedDim        color.ARGB `json:"tertiary_fixed_dim"         yaml:"tertiary_fixed_dim"         toml:"tertiary_fixed_dim"`
+	OnTertiaryFixed         color.ARGB `json:"on_tertiary_fixed"          yaml:"on_tertiary_fixed"          toml:"on_tertiary_fixed"`
+	OnTertiaryFixedVariant  color.ARGB `json:"on_tertiary_fixed_variant"  yaml:"on_tertiary_fixed_variant"  toml:"on_tertiary_fixed_variant"`
+}
+
+// FixedRolesFromSeed creates the fixed roles of seed.
+func FixedRolesFromSeed(seed color.ARGB) FixedRoles {
+	return FixedRolesFromCorePalette(palettes.CorePaletteOf(seed))
+}
+
+// FixedRolesFromCorePalette creates the fixed roles of core with the baseline
+// tones of Material 3.
+func FixedRolesFromCorePalette(core *palettes.CorePalette) FixedRoles {
+	return FixedRoles{
+		PrimaryFixed:            core.Primary.Tone(90),
+		PrimaryFixedDim:         core.Primary.Tone(80),
+		OnPrimaryFixed:          core.Primary.Tone(10),
+		OnPrimaryFixedVariant:   core.Primary.Tone(30),
+		SecondaryFixed:          core.Secondary.Tone(90),
+		SecondaryFixedDim:       core.Secondary.Tone(80),
+		OnSecondaryFixed:        core.Secondary.Tone(10),
+		OnSecondaryFixedVariant: core.Secondary.Tone(30),
+		TertiaryFixed:           core.Tertiary.Tone(90),
+		TertiaryFixedDim:        core.Tertiary.Tone(80),
+		OnTertiaryFixed:         core.Tertiary.Tone(10),
+		OnTertiaryFixedVariant:  core.Tertiary.Tone(30),
+	}
+}
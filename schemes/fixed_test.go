@@ -0,0 +1,42 @@
+package schemes
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/dynamic"
+)
+
+func TestFixedRoles(t *testing.T) {
+	source := color.ARGB(0xFF6750A4)
+	fixedRoles := []string{
+		"primary_fixed", "primary_fixed_dim", "on_primary_fixed", "on_primary_fixed_variant",
+		"secondary_fixed", "secondary_fixed_dim", "on_secondary_fixed", "on_secondary_fixed_variant",
+		"tertiary_fixed", "tertiary_fixed_dim", "on_tertiary_fixed", "on_tertiary_fixed_variant",
+	}
+
+	// Fixed roles don't change between light and dark scheme
+	for _, variant := range dynamic.Variants() {
+		light := dynamic.NewDynamicScheme(source.ToHct(), variant, 0, false, dynamic.Phone, dynamic.V2021,
+			nil, nil, nil, nil, nil, nil).ToARGBMap()
+		dark := dynamic.NewDynamicScheme(source.ToHct(), variant, 0, true, dynamic.Phone, dynamic.V2021,
+			nil, nil, nil, nil, nil, nil).ToARGBMap()
+		for _, role := range fixedRoles {
+			if light[role] != dark[role] {
+				t.Errorf("%s: %s light = %s, dark = %s", variant, role,
+					light[role].HexRGB(), dark[role].HexRGB())
+			}
+		}
+	}
+
+	fixed := FixedRolesFromSeed(source)
+	light := SchemeLightFromSeed(source)
+	dark := SchemeDarkFromSeed(source)
+	if fixed.PrimaryFixed != light.PrimaryContainer || fixed.PrimaryFixedDim != dark.Primary ||
+		fixed.OnPrimaryFixed != light.OnPrimaryContainer || fixed.OnPrimaryFixedVariant != dark.PrimaryContainer {
+		t.Errorf("FixedRolesFromSeed() primary roles = %+v, don't match baseline tones", fixed)
+	}
+	if fixed.TertiaryFixedDim != dark.Tertiary || fixed.SecondaryFixed != light.SecondaryContainer {
+		t.Errorf("FixedRolesFromSeed() = %+v, don't match baseline tones", fixed)
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"github.com/Nadim147c/material/dynamic"
 )
 
+// NewFruitSalad creates a playful scheme whose primary and secondary hues are
+// rotated -50 degrees from the source hue, so the source color is not in the
+// scheme.
 func NewFruitSalad(
 	sourceColor color.Hct,
 	isDark bool,
@@ -5,6 +5,8 @@ import (
 	"github.com/Nadim147c/material/dynamic"
 )
 
+// NewMonochrome creates a grayscale scheme. Every palette except error has zero
+// chroma, and color roles use tones tuned for monochrome in the color spec.
 func NewMonochrome(
 	sourceColor color.Hct,
 	isDark bool,
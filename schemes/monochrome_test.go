@@ -0,0 +1,24 @@
+package schemes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/dynamic"
+)
+
+func TestMonochromeIsGray(t *testing.T) {
+	source := color.ARGB(0xFF0000FF).ToHct()
+	for _, isDark := range []bool{false, true} {
+		scheme := NewMonochrome(source, isDark, 0, dynamic.Phone, dynamic.V2021)
+		for name, c := range scheme.ToARGBMap() {
+			if strings.Contains(name, "error") {
+				continue
+			}
+			if c.Red() != c.Green() || c.Green() != c.Blue() {
+				t.Errorf("dark=%v: %s = %s, want gray", isDark, name, c.HexRGB())
+			}
+		}
+	}
+}
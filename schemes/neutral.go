@@ -5,6 +5,8 @@ import (
 	"github.com/Nadim147c/material/dynamic"
 )
 
+// NewNeutral creates a nearly monochrome scheme. Every palette has very low
+// chroma but still follows the source hue.
 func NewNeutral(
 	sourceColor color.Hct,
 	isDark bool,
@@ -0,0 +1,41 @@
+package schemes
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/dynamic"
+)
+
+func TestSchemePaletteChroma(t *testing.T) {
+	tests := []struct {
+		name   string
+		scheme schemeConstructor
+		want   []float64
+	}{
+		{"TonalSpot", NewTonalSpot, []float64{36, 16, 24, 6, 8}},
+		{"Neutral", NewNeutral, []float64{12, 8, 16, 2, 2}},
+		{"Monochrome", NewMonochrome, []float64{0, 0, 0, 0, 0}},
+		{"Rainbow", NewRainbow, []float64{48, 16, 24, 0, 0}},
+		{"FruitSalad", NewFruitSalad, []float64{48, 36, 36, 10, 16}},
+	}
+
+	source := color.ARGB(0xFF0000FF).ToHct()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := tt.scheme(source, false, 0, dynamic.Phone, dynamic.V2021)
+			chroma := []float64{
+				scheme.PrimaryPalette.Chroma,
+				scheme.SecondaryPalette.Chroma,
+				scheme.TertiaryPalette.Chroma,
+				scheme.NeutralPalette.Chroma,
+				scheme.NeutralVariantPalette.Chroma,
+			}
+			for i := range tt.want {
+				if chroma[i] != tt.want[i] {
+					t.Errorf("palette %d chroma = %v, want %v", i, chroma[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/Nadim147c/material/dynamic"
 )
 
+// NewRainbow creates a playful scheme with colorful accents on top of gray
+// neutral palettes. The tertiary hue is rotated 60 degrees.
 func NewRainbow(
 	sourceColor color.Hct,
 	isDark bool,
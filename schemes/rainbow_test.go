@@ -0,0 +1,28 @@
+package schemes
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/dynamic"
+	"github.com/Nadim147c/material/num"
+)
+
+func TestPlayfulSchemeHues(t *testing.T) {
+	source := color.ARGB(0xFF0000FF).ToHct()
+
+	rainbow := NewRainbow(source, false, 0, dynamic.Phone, dynamic.V2021)
+	if want := num.NormalizeDegree(source.Hue + 60); rainbow.TertiaryPalette.Hue != want {
+		t.Errorf("Rainbow tertiary hue = %v, want %v", rainbow.TertiaryPalette.Hue, want)
+	}
+
+	fruitSalad := NewFruitSalad(source, false, 0, dynamic.Phone, dynamic.V2021)
+	want := num.NormalizeDegree(source.Hue - 50)
+	if fruitSalad.PrimaryPalette.Hue != want || fruitSalad.SecondaryPalette.Hue != want {
+		t.Errorf("FruitSalad primary and secondary hue = %v, %v, want %v",
+			fruitSalad.PrimaryPalette.Hue, fruitSalad.SecondaryPalette.Hue, want)
+	}
+	if fruitSalad.TertiaryPalette.Hue != source.Hue {
+		t.Errorf("FruitSalad tertiary hue = %v, want %v", fruitSalad.TertiaryPalette.Hue, source.Hue)
+	}
+}
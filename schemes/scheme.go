@@ -0,0 +1,169 @@
+package schemes
+
+import (
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/palettes"
+)
+
+// Scheme is the baseline Material 3 color scheme with fixed tones. Unlike
+// dynamic.DynamicScheme it does not adjust tones for contrast. Use it when the
+// classic light and dark schemes are enough.
+type Scheme struct {
+	Primary              color.ARGB `json:"primary"                yaml:"primary"                toml:"primary"`
+	OnPrimary            color.ARGB `json:"on_primary"             yaml:"on_primary"             toml:"on_primary"`
+	PrimaryContainer     color.ARGB `json:"primary_container"      yaml:"primary_container"      toml:"primary_container"`
+	OnPrimaryContainer   color.ARGB `json:"on_primary_container"   yaml:"on_primary_container"   toml:"on_primary_container"`
+	Secondary            color.ARGB `json:"secondary"              yaml:"secondary"              toml:"secondary"`
+	OnSecondary          color.ARGB `json:"on_secondary"           yaml:"on_secondary"           toml:"on_secondary"`
+	SecondaryContainer   color.ARGB `json:"secondary_container"    yaml:"secondary_container"    toml:"secondary_container"`
+	OnSecondaryContainer color.ARGB `json:"on_secondary_container" yaml:"on_secondary_container" toml:"on_secondary_container"`
+	Tertiary             color.ARGB `json:"tertiary"               yaml:"tertiary"               toml:"tertiary"`
+	OnTertiary           color.ARGB `json:"on_tertiary"            yaml:"on_tertiary"            toml:"on_tertiary"`
+	TertiaryContainer    color.ARGB `json:"tertiary_container"     yaml:"tertiary_container"     toml:"tertiary_container"`
+	OnTertiaryContainer  color.ARGB `json:"on_tertiary_container"  yaml:"on_tertiary_container"  toml:"on_tertiary_container"`
+	Error                color.ARGB `json:"error"                  yaml:"error"                  toml:"error"`
+	OnError              color.ARGB `json:"on_error"               yaml:"on_error"               toml:"on_error"`
+	ErrorContainer       color.ARGB `json:"error_container"        yaml:"error_container"        toml:"error_container"`
+	OnErrorContainer     color.ARGB `json:"on_error_container"     yaml:"on_error_container"     toml:"on_error_container"`
+	Background           color.ARGB `json:"background"             yaml:"background"             toml:"background"`
+	OnBackground         color.ARGB `json:"on_background"          yaml:"on_background"          toml:"on_background"`
+	Surface              color.ARGB `json:"surface"                yaml:"surface"                toml:"surface"`
+	OnSurface            color.ARGB `json:"on_surface"             yaml:"on_surface"             toml:"on_surface"`
+	SurfaceVariant       color.ARGB `json:"surface_variant"        yaml:"surface_variant"        toml:"surface_variant"`
+	OnSurfaceVariant     color.ARGB `json:"on_surface_variant"     yaml:"on_surface_variant"     toml:"on_surface_variant"`
+	Outline              color.ARGB `json:"outline"                yaml:"outline"                toml:"outline"`
+	OutlineVariant       color.ARGB `json:"outline_variant"        yaml:"outline_variant"        toml:"outline_variant"`
+	Shadow               color.ARGB `json:"shadow"                 yaml:"shadow"                 toml:"shadow"`
+	Scrim                color.ARGB `json:"scrim"                  yaml:"scrim"                  toml:"scrim"`
+	InverseSurface       color.ARGB `json:"inverse_surface"        yaml:"inverse_surface"        toml:"inverse_surface"`
+	InverseOnSurface     color.ARGB `json:"inverse_on_surface"     yaml:"inverse_on_surface"     toml:"inverse_on_surface"`
+	InversePrimary       color.ARGB `json:"inverse_primary"        yaml:"inverse_primary"        toml:"inverse_primary"`
+}
+
+// SchemeLightFromSeed creates the baseline light scheme of seed.
+func SchemeLightFromSeed(seed color.ARGB) Scheme {
+	return LightSchemeFromCorePalette(palettes.CorePaletteOf(seed))
+}
+
+// SchemeDarkFromSeed creates the baseline dark scheme of seed.
+func SchemeDarkFromSeed(seed color.ARGB) Scheme {
+	return DarkSchemeFromCorePalette(palettes.CorePaletteOf(seed))
+}
+
+// SchemeLightContentFromSeed is like SchemeLightFromSeed but keeps the chroma
+// of seed.
+func SchemeLightContentFromSeed(seed color.ARGB) Scheme {
+	return LightSchemeFromCorePalette(palettes.ContentCorePaletteOf(seed))
+}
+
+// SchemeDarkContentFromSeed is like SchemeDarkFromSeed but keeps the chroma of
+// seed.
+func SchemeDarkContentFromSeed(seed color.ARGB) Scheme {
+	return DarkSchemeFromCorePalette(palettes.ContentCorePaletteOf(seed))
+}
+
+// LightSchemeFromCorePalette creates the baseline light scheme of core.
+func LightSchemeFromCorePalette(core *palettes.CorePalette) Scheme {
+	return Scheme{
+		Primary:              core.Primary.Tone(40),
+		OnPrimary:            core.Primary.Tone(100),
+		PrimaryContainer:     core.Primary.Tone(90),
+		OnPrimaryContainer:   core.Primary.Tone(10),
+		Secondary:            core.Secondary.Tone(40),
+		OnSecondary:          core.Secondary.Tone(100),
+		SecondaryContainer:   core.Secondary.Tone(90),
+		OnSecondaryContainer: core.Secondary.Tone(10),
+		Tertiary:             core.Tertiary.Tone(40),
+		OnTertiary:           core.Tertiary.Tone(100),
+		TertiaryContainer:    core.Tertiary.Tone(90),
+		OnTertiaryContainer:  core.Tertiary.Tone(10),
+		Error:                core.Error.Tone(40),
+		OnError:              core.Error.Tone(100),
+		ErrorContainer:       core.Error.Tone(90),
+		OnErrorContainer:     core.Error.Tone(10),
+		Background:           core.Neutral.Tone(99),
+		OnBackground:         core.Neutral.Tone(10),
+		Surface:              core.Neutral.Tone(99),
+		OnSurface:            core.Neutral.Tone(10),
+		SurfaceVariant:       core.NeutralVariant.Tone(90),
+		OnSurfaceVariant:     core.NeutralVariant.Tone(30),
+		Outline:              core.NeutralVariant.Tone(50),
+		OutlineVariant:       core.NeutralVariant.Tone(80),
+		Shadow:               core.Neutral.Tone(0),
+		Scrim:                core.Neutral.Tone(0),
+		InverseSurface:       core.Neutral.Tone(20),
+		InverseOnSurface:     core.Neutral.Tone(95),
+		InversePrimary:       core.Primary.Tone(80),
+	}
+}
+
+// DarkSchemeFromCorePalette creates the baseline dark scheme of core.
+func DarkSchemeFromCorePalette(core *palettes.CorePalette) Scheme {
+	return Scheme{
+		Primary:              core.Primary.Tone(80),
+		OnPrimary:            core.Primary.Tone(20),
+		PrimaryContainer:     core.Primary.Tone(30),
+		OnPrimaryContainer:   core.Primary.Tone(90),
+		Secondary:            core.Secondary.Tone(80),
+		OnSecondary:          core.Secondary.Tone(20),
+		SecondaryContainer:   core.Secondary.Tone(30),
+		OnSecondaryContainer: core.Secondary.Tone(90),
+		Tertiary:             core.Tertiary.Tone(80),
+		OnTertiary:           core.Tertiary.Tone(20),
+		TertiaryContainer:    core.Tertiary.Tone(30),
+		OnTertiaryContainer:  core.Tertiary.Tone(90),
+		Error:                core.Error.Tone(80),
+		OnError:              core.Error.Tone(20),
+		ErrorContainer:       core.Error.Tone(30),
+		OnErrorContainer:     core.Error.Tone(80),
+		Background:           core.Neutral.Tone(10),
+		OnBackground:         core.Neutral.Tone(90),
+		Surface:              core.Neutral.Tone(10),
+		OnSurface:            core.Neutral.Tone(90),
+		SurfaceVariant:       core.NeutralVariant.Tone(30),
+		OnSurfaceVariant:     core.NeutralVariant.Tone(80),
+		Outline:              core.NeutralVariant.Tone(60),
+		OutlineVariant:       core.NeutralVariant.Tone(30),
+		Shadow:               core.Neutral.Tone(0),
+		Scrim:                core.Neutral.Tone(0),
+		InverseSurface:       core.Neutral.Tone(90),
+		InverseOnSurface:     core.Neutral.Tone(20),
+		InversePrimary:       core.Primary.Tone(40),
+	}
+}
+
+// ToARGBMap returns every role of s keyed by snake case role name, like
+// dynamic.DynamicScheme.ToARGBMap.
+func (s Scheme) ToARGBMap() map[string]color.ARGB {
+	return map[string]color.ARGB{
+		"primary":                s.Primary,
+		"on_primary":             s.OnPrimary,
+		"primary_container":      s.PrimaryContainer,
+		"on_primary_container":   s.OnPrimaryContainer,
+		"secondary":              s.Secondary,
+		"on_secondary":           s.OnSecondary,
+		"secondary_container":    s.SecondaryContainer,
+		"on_secondary_container": s.OnSecondaryContainer,
+		"tertiary":               s.Tertiary,
+		"on_tertiary":            s.OnTertiary,
+		"tertiary_container":     s.TertiaryContainer,
+		"on_tertiary_container":  s.OnTertiaryContainer,
+		"error":                  s.Error,
+		"on_error":               s.OnError,
+		"error_container":        s.ErrorContainer,
+		"on_error_container":     s.OnErrorContainer,
+		"background":             s.Background,
+		"on_background":          s.OnBackground,
+		"surface":                s.Surface,
+		"on_surface":             s.OnSurface,
+		"surface_variant":        s.SurfaceVariant,
+		"on_surface_variant":     s.OnSurfaceVariant,
+		"outline":                s.Outline,
+		"outline_variant":        s.OutlineVariant,
+		"shadow":                 s.Shadow,
+		"scrim":                  s.Scrim,
+		"inverse_surface":        s.InverseSurface,
+		"inverse_on_surface":     s.InverseOnSurface,
+		"inverse_primary":        s.InversePrimary,
+	}
+}
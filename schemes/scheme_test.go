@@ -0,0 +1,67 @@
+package schemes
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/dynamic"
+)
+
+type schemeConstructor func(color.Hct, bool, float64, dynamic.Platform, dynamic.Version) dynamic.DynamicScheme
+
+var schemeGoldenTests = []struct {
+	name   string
+	scheme schemeConstructor
+	isDark bool
+	role   func(dynamic.DynamicScheme) color.ARGB
+	want   color.ARGB
+}{
+	{"TonalSpot/Light/Primary", NewTonalSpot, false, dynamic.DynamicScheme.Primary, 0xFF555992},
+	{"TonalSpot/Dark/Primary", NewTonalSpot, true, dynamic.DynamicScheme.Primary, 0xFFBEC2FF},
+	{"TonalSpot/Light/PrimaryContainer", NewTonalSpot, false, dynamic.DynamicScheme.PrimaryContainer, 0xFFE0E0FF},
+	{"TonalSpot/Light/OnPrimaryContainer", NewTonalSpot, false, dynamic.DynamicScheme.OnPrimaryContainer, 0xFF3E4278},
+	{"TonalSpot/Light/Surface", NewTonalSpot, false, dynamic.DynamicScheme.Surface, 0xFFFBF8FF},
+	{"Vibrant/Light/Primary", NewVibrant, false, dynamic.DynamicScheme.Primary, 0xFF343DFF},
+	{"Vibrant/Dark/Primary", NewVibrant, true, dynamic.DynamicScheme.Primary, 0xFFBEC2FF},
+	{"Monochrome/Light/Primary", NewMonochrome, false, dynamic.DynamicScheme.Primary, 0xFF000000},
+	{"Monochrome/Dark/Primary", NewMonochrome, true, dynamic.DynamicScheme.Primary, 0xFFFFFFFF},
+	{"Monochrome/Light/OnPrimaryContainer", NewMonochrome, false, dynamic.DynamicScheme.OnPrimaryContainer, 0xFFFFFFFF},
+	{"Vibrant/Light/PrimaryContainer", NewVibrant, false, dynamic.DynamicScheme.PrimaryContainer, 0xFFE0E0FF},
+}
+
+func TestSchemeGolden(t *testing.T) {
+	source := color.ARGB(0xFF0000FF).ToHct()
+	for _, tt := range schemeGoldenTests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := tt.scheme(source, tt.isDark, 0, dynamic.Phone, dynamic.V2021)
+			if got := tt.role(scheme); got != tt.want {
+				t.Errorf("got %s, want %s", got.HexRGB(), tt.want.HexRGB())
+			}
+		})
+	}
+}
+
+func TestSchemeFromSeed(t *testing.T) {
+	tests := []struct {
+		name      string
+		got, want color.ARGB
+	}{
+		{"Blue/Light/Primary", SchemeLightFromSeed(0xFF0000FF).Primary, 0xFF343DFF},
+		{"Blue/Dark/Primary", SchemeDarkFromSeed(0xFF0000FF).Primary, 0xFFBEC2FF},
+		{"Purple/Light/Primary", SchemeLightFromSeed(0xFF6750A4).Primary, 0xFF6750A4},
+		{"Purple/Light/Secondary", SchemeLightFromSeed(0xFF6750A4).Secondary, 0xFF625B71},
+		{"Purple/Light/Tertiary", SchemeLightFromSeed(0xFF6750A4).Tertiary, 0xFF7E5260},
+		{"Purple/Light/Surface", SchemeLightFromSeed(0xFF6750A4).Surface, 0xFFFFFBFF},
+		{"Purple/Light/OnSurface", SchemeLightFromSeed(0xFF6750A4).OnSurface, 0xFF1C1B1E},
+		{"Purple/Dark/Primary", SchemeDarkFromSeed(0xFF6750A4).Primary, 0xFFCFBCFF},
+		{"Purple/Dark/Secondary", SchemeDarkFromSeed(0xFF6750A4).Secondary, 0xFFCBC2DB},
+		{"Purple/Dark/Tertiary", SchemeDarkFromSeed(0xFF6750A4).Tertiary, 0xFFEFB8C8},
+		{"Purple/Dark/Surface", SchemeDarkFromSeed(0xFF6750A4).Surface, 0xFF1C1B1E},
+		{"Purple/Dark/OnSurface", SchemeDarkFromSeed(0xFF6750A4).OnSurface, 0xFFE6E1E6},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, tt.got.HexRGB(), tt.want.HexRGB())
+		}
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"github.com/Nadim147c/material/dynamic"
 )
 
+// NewTonalSpot creates the default Material You scheme. It has a calm primary
+// palette with chroma 36, muted secondary and tertiary palettes and nearly
+// gray neutral palettes.
 func NewTonalSpot(
 	sourceColor color.Hct,
 	isDark bool,
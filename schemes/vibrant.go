@@ -5,6 +5,8 @@ import (
 	"github.com/Nadim147c/material/dynamic"
 )
 
+// NewVibrant creates a scheme with maximum chroma primary palette. Secondary and
+// tertiary hues are rotated from the source hue by piecewise tables.
 func NewVibrant(
 	sourceColor color.Hct,
 	isDark bool,
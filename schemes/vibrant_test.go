@@ -0,0 +1,34 @@
+package schemes
+
+import (
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/dynamic"
+	"github.com/Nadim147c/material/num"
+)
+
+func TestVibrantHueRotation(t *testing.T) {
+	tests := []struct {
+		hue       float64
+		secondary float64
+		tertiary  float64
+	}{
+		{20, 18, 35},
+		{50, 15, 30},
+		{120, 12, 25},
+		{282.7882, 15, 30},
+		{330, 12, 25},
+	}
+
+	for _, tt := range tests {
+		source := color.NewHct(tt.hue, 48, 50)
+		scheme := NewVibrant(source, false, 0, dynamic.Phone, dynamic.V2021)
+		if want := num.NormalizeDegree(source.Hue + tt.secondary); scheme.SecondaryPalette.Hue != want {
+			t.Errorf("hue %v: secondary hue = %v, want %v", tt.hue, scheme.SecondaryPalette.Hue, want)
+		}
+		if want := num.NormalizeDegree(source.Hue + tt.tertiary); scheme.TertiaryPalette.Hue != want {
+			t.Errorf("hue %v: tertiary hue = %v, want %v", tt.hue, scheme.TertiaryPalette.Hue, want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package score
 
 import (
+	"maps"
 	"math"
 	"slices"
 	"sort"
@@ -67,7 +68,7 @@ func SanitizeDegreesInt(degrees int) int {
 
 // DifferenceDegrees returns the shortest angular difference between two angles in degrees.
 func DifferenceDegrees(a, b float64) float64 {
-	return num.NormalizeDegree(a - b)
+	return num.DifferenceDegrees(a, b)
 }
 
 // ScoreColors ranks colors based on suitability for being used for a UI theme.
@@ -95,7 +96,10 @@ func (s *score) ScoreColors(colorsToPopulation map[color.ARGB]int, opts ScoreOpt
 	huePopulation := make([]int, 360)
 	populationSum := 0
 
-	for argb, population := range colorsToPopulation {
+	// Map iteration order is random, visit colors in a fixed order so that
+	// colors with equal score are always ranked the same way.
+	for argb := range slices.Values(slices.Sorted(maps.Keys(colorsToPopulation))) {
+		population := colorsToPopulation[argb]
 		hct := argb.ToHct()
 		colorsHct = append(colorsHct, hct)
 		hue := int(math.Floor(hct.Hue))
@@ -140,7 +144,7 @@ func (s *score) ScoreColors(colorsToPopulation map[color.ARGB]int, opts ScoreOpt
 	}
 
 	// Sort so that colors with higher scores come first
-	sort.Slice(scoredHct, func(i, j int) bool {
+	sort.SliceStable(scoredHct, func(i, j int) bool {
 		return scoredHct[i].score > scoredHct[j].score
 	})
 
@@ -130,8 +130,8 @@ func TestScoring(t *testing.T) {
 
 		ranked := Score(colorsToPopulation, ScoreOptions{Desired: 4, Fallback: 0xff7d772b, Filter: true})
 
-		if len(ranked) != 4 {
-			t.Errorf("Expected 3 colors, got %d", len(ranked))
+		if len(ranked) != 3 {
+			t.Fatalf("Expected 3 colors, got %d", len(ranked))
 		}
 
 		if ranked[0] != b {
@@ -140,11 +140,23 @@ func TestScoring(t *testing.T) {
 		if ranked[1] != d {
 			t.Errorf("Expected %v, got %v", d, ranked[1])
 		}
-		if ranked[2] != c {
-			t.Errorf("Expected %v, got %v", c, ranked[2])
+		if ranked[2] != a {
+			t.Errorf("Expected %v, got %v", a, ranked[2])
+		}
+	})
+
+	t.Run("dedupes hues across zero degrees", func(t *testing.T) {
+		red := color.NewHct(2, 60, 50).ToARGB()
+		pink := color.NewHct(356, 50, 50).ToARGB()
+		colorsToPopulation := map[color.ARGB]int{red: 1, pink: 1}
+
+		ranked := Score(colorsToPopulation, ScoreOptions{Desired: 4})
+
+		if len(ranked) != 1 {
+			t.Errorf("Expected 1 color, got %d", len(ranked))
 		}
-		if ranked[3] != a {
-			t.Errorf("Expected %v, got %v", a, ranked[3])
+		if ranked[0] != red {
+			t.Errorf("Expected %v, got %v", red, ranked[0])
 		}
 	})
 }
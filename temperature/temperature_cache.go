@@ -2,6 +2,7 @@ package temperature
 
 import (
 	"math"
+	"slices"
 	"sort"
 
 	"github.com/Nadim147c/material/color"
@@ -44,14 +45,15 @@ func (t *TemperatureCache) HctsByTemp() []color.Hct {
 		return t.hctsByTempCache
 	}
 
-	hcts := append(t.HctsByHue(), t.input)
+	hcts := append(slices.Clone(t.HctsByHue()), t.input)
 	temperaturesByHct := t.TempsByHct()
 
-	sort.Slice(hcts, func(i, j int) bool {
+	sort.SliceStable(hcts, func(i, j int) bool {
 		return temperaturesByHct[hcts[i].Hash()] < temperaturesByHct[hcts[j].Hash()]
 	})
 
-	return hcts
+	t.hctsByTempCache = hcts
+	return t.hctsByTempCache
 }
 
 // Warmest returns the warmest color in the cache.
@@ -263,7 +265,7 @@ func (t *TemperatureCache) TempsByHct() ColorMap {
 		return t.tempsByHctCache
 	}
 
-	allHcts := append(t.HctsByHue(), t.input)
+	allHcts := append(slices.Clone(t.HctsByHue()), t.input)
 	temperaturesByHct := make(ColorMap)
 
 	for _, e := range allHcts {
@@ -232,3 +232,25 @@ func TestAnalogous(t *testing.T) {
 		})
 	}
 }
+
+func TestHctsByTemp(t *testing.T) {
+	cache := NewTemperatureCache(color.ARGB(0xff0000ff).ToHct())
+	hcts := cache.HctsByTemp()
+	if len(hcts) != 362 {
+		t.Fatalf("len(HctsByTemp()) = %d, want 362", len(hcts))
+	}
+
+	temps := cache.TempsByHct()
+	for i := 1; i < len(hcts); i++ {
+		if temps[hcts[i-1].Hash()] > temps[hcts[i].Hash()] {
+			t.Fatalf("HctsByTemp() is not sorted at index %d", i)
+		}
+	}
+
+	if cache.Coldest() != hcts[0] || cache.Warmest() != hcts[len(hcts)-1] {
+		t.Errorf("Coldest/Warmest do not match the ends of HctsByTemp()")
+	}
+	if &cache.HctsByTemp()[0] != &hcts[0] {
+		t.Errorf("HctsByTemp() is not memoized")
+	}
+}
@@ -0,0 +1,93 @@
+package theme
+
+import (
+	"fmt"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/contrast"
+	"github.com/Nadim147c/material/palettes"
+	"github.com/Nadim147c/material/schemes"
+	"github.com/Nadim147c/material/score"
+)
+
+// FromBrandColors maps an existing brand palette onto a Theme, the inverse of
+// generating a theme from a seed. Brand colors are expected in order of
+// importance.
+//
+// The first chromatic color becomes the primary palette. Of the next two
+// chromatic colors, the one closest in hue to the primary becomes the
+// secondary palette and the other the tertiary accent, like AssignByHue of
+// FromSeeds. The palettes keep the hue and chroma of the brand colors, so the
+// brand colors reappear at their own tone. The first nearly achromatic color,
+// see ValidateSeed, tints the neutral palettes. Remaining chromatic colors are
+// added as custom colors named brand_1, brand_2 and so on, harmonized with the
+// primary color.
+//
+// A brand color is used as is for its role in the light and dark scheme when
+// it has WCAG AA contrast with its on-color and 3:1 with the surface.
+// Otherwise the role keeps the tone of the baseline scheme, which fixes the
+// contrast while keeping hue and chroma. InversePrimary follows the primary of
+// the opposite scheme, as in the baseline scheme. The container roles keep
+// their baseline tones of the brand palettes.
+//
+// If brand has no chromatic color, score.FallbackColor seeds the palettes and
+// every role keeps its baseline tone.
+func FromBrandColors(brand []color.ARGB, customColors ...schemes.CustomColor) *Theme {
+	var chromatic, neutral []color.ARGB
+	for _, c := range brand {
+		if ValidateSeed(c) == nil {
+			chromatic = append(chromatic, c)
+		} else {
+			neutral = append(neutral, c)
+		}
+	}
+	var primary, secondary, tertiary color.ARGB
+	source := score.FallbackColor
+	if len(chromatic) > 0 {
+		primary = chromatic[0]
+		secondary, tertiary = assignSeeds(chromatic, AssignByHue)
+		source = primary
+	}
+
+	core := palettes.CorePaletteOf(source)
+	core.Primary = palettes.NewFromARGB(source)
+	if secondary != 0 {
+		core.Secondary = palettes.NewFromARGB(secondary)
+	}
+	if tertiary != 0 {
+		core.Tertiary = palettes.NewFromARGB(tertiary)
+	}
+	if len(neutral) > 0 {
+		hct := neutral[0].ToHct()
+		core.Neutral = palettes.FromHueAndChroma(hct.Hue, min(hct.Chroma, 4))
+		core.NeutralVariant = palettes.FromHueAndChroma(hct.Hue, min(hct.Chroma*2, 8))
+	}
+
+	extra := make([]schemes.CustomColor, 0, len(chromatic))
+	for i, c := range chromatic[min(len(chromatic), 3):] {
+		extra = append(extra, schemes.CustomColor{Name: fmt.Sprintf("brand_%d", i+1), Value: c, Blend: true})
+	}
+
+	theme := fromCorePalette(source, core, append(extra, customColors...))
+	for _, scheme := range []*schemes.Scheme{&theme.Schemes.Light, &theme.Schemes.Dark} {
+		scheme.Primary = brandRole(primary, scheme.Primary, scheme.OnPrimary, scheme.Surface)
+		scheme.Secondary = brandRole(secondary, scheme.Secondary, scheme.OnSecondary, scheme.Surface)
+		scheme.Tertiary = brandRole(tertiary, scheme.Tertiary, scheme.OnTertiary, scheme.Surface)
+	}
+	theme.Schemes.Light.InversePrimary = theme.Schemes.Dark.Primary
+	theme.Schemes.Dark.InversePrimary = theme.Schemes.Light.Primary
+	return theme
+}
+
+// brandRole returns c if it has enough contrast with on and surface to replace
+// role, and role otherwise. A zero c is no brand color.
+func brandRole(c, role, on, surface color.ARGB) color.ARGB {
+	if c == 0 {
+		return role
+	}
+	if contrast.Classify(c.ContrastRatio(on), contrast.NormalText) == contrast.Fail ||
+		contrast.Classify(c.ContrastRatio(surface), contrast.LargeText) == contrast.Fail {
+		return role
+	}
+	return c
+}
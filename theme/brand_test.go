@@ -0,0 +1,93 @@
+package theme
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/contrast"
+	"github.com/Nadim147c/material/num"
+	"github.com/Nadim147c/material/schemes"
+	"github.com/Nadim147c/material/score"
+)
+
+func TestFromBrandColors(t *testing.T) {
+	navy := color.ARGB(0xFF1A3A6B)
+	orange := color.ARGB(0xFFF28C28)
+	teal := color.ARGB(0xFF00897B)
+	pink := color.ARGB(0xFFE91E63)
+	charcoal := color.ARGB(0xFF333333)
+
+	theme := FromBrandColors([]color.ARGB{charcoal, navy, orange, teal, pink})
+	if theme.Source != navy {
+		t.Errorf("Source = %s, want %s", theme.Source.HexRGB(), navy.HexRGB())
+	}
+
+	// Navy is accessible on white, orange is not
+	light := theme.Schemes.Light
+	if light.Primary != navy {
+		t.Errorf("light primary = %s, want brand %s", light.Primary.HexRGB(), navy.HexRGB())
+	}
+	if light.Tertiary == orange {
+		t.Errorf("light tertiary = %s, want contrast fixed", light.Tertiary.HexRGB())
+	}
+	if num.DifferenceDegrees(light.Tertiary.ToHct().Hue, orange.ToHct().Hue) > 5 {
+		t.Errorf("light tertiary hue = %.1f, want orange %.1f", light.Tertiary.ToHct().Hue, orange.ToHct().Hue)
+	}
+	if got := theme.Schemes.Dark.Tertiary; got != orange {
+		t.Errorf("dark tertiary = %s, want brand %s", got.HexRGB(), orange.HexRGB())
+	}
+
+	// Teal is closer in hue to navy than orange, so it is the secondary
+	if num.DifferenceDegrees(theme.Palettes.Secondary.Hue, teal.ToHct().Hue) > 0.01 {
+		t.Errorf("secondary hue = %.1f, want teal %.1f", theme.Palettes.Secondary.Hue, teal.ToHct().Hue)
+	}
+	if num.DifferenceDegrees(theme.Palettes.Tertiary.Hue, orange.ToHct().Hue) > 0.01 {
+		t.Errorf("tertiary hue = %.1f, want orange %.1f", theme.Palettes.Tertiary.Hue, orange.ToHct().Hue)
+	}
+
+	if got := theme.Palettes.Primary.Chroma; math.Abs(got-navy.ToHct().Chroma) > 0.01 {
+		t.Errorf("primary chroma = %.1f, want brand chroma %.1f", got, navy.ToHct().Chroma)
+	}
+	if got := theme.Palettes.Neutral.Chroma; got > 4 {
+		t.Errorf("neutral chroma = %.1f, want at most 4", got)
+	}
+
+	if len(theme.CustomColors) != 1 || theme.CustomColors[0].Color.Name != "brand_1" ||
+		theme.CustomColors[0].Color.Value != pink {
+		t.Errorf("CustomColors = %+v, want pink as brand_1", theme.CustomColors)
+	}
+
+	for name, report := range map[string]contrast.AuditReport{
+		"light": contrast.AuditScheme(theme.Schemes.Light),
+		"dark":  contrast.AuditScheme(theme.Schemes.Dark),
+	} {
+		for _, f := range report.Failures() {
+			t.Errorf("%s: %s on %s = %.2f, want %v", name, f.Foreground, f.Background, f.Ratio, f.MinRatio)
+		}
+	}
+
+	if theme.Schemes.Light.InversePrimary != theme.Schemes.Dark.Primary ||
+		theme.Schemes.Dark.InversePrimary != navy {
+		t.Errorf("InversePrimary = %s, %s, want the primary of the opposite scheme",
+			theme.Schemes.Light.InversePrimary.HexRGB(), theme.Schemes.Dark.InversePrimary.HexRGB())
+	}
+}
+
+func TestFromBrandColors_Fallback(t *testing.T) {
+	charcoal := color.ARGB(0xFF333333)
+
+	theme := FromBrandColors([]color.ARGB{charcoal})
+	if theme.Source != score.FallbackColor {
+		t.Errorf("Source = %s, want fallback %s", theme.Source.HexRGB(), score.FallbackColor.HexRGB())
+	}
+
+	// Without a brand color every role keeps the baseline tone
+	core := theme.Palettes
+	if got, want := theme.Schemes.Light, schemes.LightSchemeFromCorePalette(core); got != want {
+		t.Errorf("light scheme = %+v, want baseline %+v", got, want)
+	}
+	if got, want := theme.Schemes.Dark, schemes.DarkSchemeFromCorePalette(core); got != want {
+		t.Errorf("dark scheme = %+v, want baseline %+v", got, want)
+	}
+}
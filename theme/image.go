@@ -0,0 +1,155 @@
+package theme
+
+import (
+	"image"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/quantizer"
+	"github.com/Nadim147c/material/schemes"
+	"github.com/Nadim147c/material/score"
+)
+
+// imageOptions configures FromImage and SourceColorFromImage.
+type imageOptions struct {
+	maxSize      int
+	maxColors    int
+	score        score.ScoreOptions
+	fallback     color.ARGB
+	assign       SeedAssignment
+	customColors []schemes.CustomColor
+}
+
+// ImageOption configures how a source color is extracted from an image.
+type ImageOption func(*imageOptions)
+
+// WithMaxSize downsamples the image so that neither side is larger than size
+// pixels before quantization. Size 0 disables downsampling. Default is 128.
+func WithMaxSize(size int) ImageOption {
+	return func(o *imageOptions) {
+		o.maxSize = size
+	}
+}
+
+// WithMaxColors sets the number of colors the image is quantized to. Default
+// is 128.
+func WithMaxColors(n int) ImageOption {
+	return func(o *imageOptions) {
+		o.maxColors = n
+	}
+}
+
+// WithScoreOptions sets options used to rank quantized colors. Default filters
+// out grayscale and rarely used colors and falls back to
+// score.FallbackColor.
+func WithScoreOptions(opts score.ScoreOptions) ImageOption {
+	return func(o *imageOptions) {
+		o.score = opts
+	}
+}
+
+// WithFallback sets the source color used when the image has no color that
+// passes ValidateSeed. It takes precedence over the Fallback of
+// WithScoreOptions. Default is score.FallbackColor.
+func WithFallback(c color.ARGB) ImageOption {
+	return func(o *imageOptions) {
+		o.fallback = c
+	}
+}
+
+// WithSeedAssignment makes FromImage build the Theme from the top three
+// source colors of the image with FromSeeds. Default is SingleSeed.
+func WithSeedAssignment(assign SeedAssignment) ImageOption {
+	return func(o *imageOptions) {
+		o.assign = assign
+	}
+}
+
+// WithCustomColors adds custom colors to the Theme generated by FromImage.
+func WithCustomColors(colors ...schemes.CustomColor) ImageOption {
+	return func(o *imageOptions) {
+		o.customColors = append(o.customColors, colors...)
+	}
+}
+
+func newImageOptions(opts []ImageOption) imageOptions {
+	o := imageOptions{
+		maxSize:   128,
+		maxColors: 128,
+		score:     score.ScoreOptions{Desired: 4, Filter: true},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.fallback != 0 {
+		o.score.Fallback = o.fallback
+	}
+	if o.score.Fallback == 0 {
+		o.score.Fallback = score.FallbackColor
+	}
+	return o
+}
+
+// Pixels returns opaque pixels of img, sampled so that neither side is larger
+// than maxSize. Size 0 returns every pixel.
+func Pixels(img image.Image, maxSize int) []color.ARGB {
+	bounds := img.Bounds()
+	step := 1
+	if longest := max(bounds.Dx(), bounds.Dy()); maxSize > 0 && longest > maxSize {
+		step = (longest + maxSize - 1) / maxSize
+	}
+
+	pixels := make([]color.ARGB, 0, (bounds.Dx()/step+1)*(bounds.Dy()/step+1))
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += step {
+		for x := bounds.Min.X; x < bounds.Max.X; x += step {
+			argb := color.ARGBFromInterface(img.At(x, y))
+			if argb.Alpha() < 0xFF {
+				continue
+			}
+			pixels = append(pixels, argb)
+		}
+	}
+	return pixels
+}
+
+// SourceColorsFromImage returns colors of img ranked by suitability as theme
+// source color. The image is downsampled, quantized with Celebi and the colors
+// are ranked with score.Score. At least one color is always returned.
+func SourceColorsFromImage(img image.Image, opts ...ImageOption) []color.ARGB {
+	o := newImageOptions(opts)
+	quantized := quantizer.QuantizeCelebi(Pixels(img, o.maxSize), o.maxColors)
+	return score.Score(quantized, o.score)
+}
+
+// SourceColorFromImage returns the color of img best suited as theme source
+// color. Colors rejected by ValidateSeed are skipped and the fallback color is
+// returned if no color is left, see WithFallback.
+func SourceColorFromImage(img image.Image, opts ...ImageOption) color.ARGB {
+	o := newImageOptions(opts)
+	for _, c := range SourceColorsFromImage(img, opts...) {
+		if ValidateSeed(c) == nil {
+			return c
+		}
+	}
+	return o.score.Fallback
+}
+
+// FromImage generates a Theme from the best source color of img. See
+// SourceColorsFromImage. With WithSeedAssignment, up to three source colors
+// are used, see FromSeeds.
+func FromImage(img image.Image, opts ...ImageOption) *Theme {
+	o := newImageOptions(opts)
+	if o.assign == SingleSeed {
+		return FromSource(SourceColorFromImage(img, opts...), o.customColors...)
+	}
+
+	seeds := make([]color.ARGB, 0, 3)
+	for _, c := range SourceColorsFromImage(img, opts...) {
+		if ValidateSeed(c) == nil && len(seeds) < 3 {
+			seeds = append(seeds, c)
+		}
+	}
+	if len(seeds) == 0 {
+		seeds = append(seeds, o.score.Fallback)
+	}
+	return FromSeeds(seeds, o.assign, o.customColors...)
+}
@@ -0,0 +1,88 @@
+package theme
+
+import (
+	"image"
+	imgcolor "image/color"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/schemes"
+	"github.com/Nadim147c/material/score"
+)
+
+// splitImage returns a image where the left 3/4 is a and the rest is b.
+func splitImage(w, h int, a, b imgcolor.Color) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			if x < w*3/4 {
+				img.Set(x, y, a)
+			} else {
+				img.Set(x, y, b)
+			}
+		}
+	}
+	return img
+}
+
+func TestPixels(t *testing.T) {
+	img := splitImage(400, 200, imgcolor.RGBA{0, 0, 255, 255}, imgcolor.RGBA{0, 0, 0, 0})
+
+	all := Pixels(img, 0)
+	if len(all) != 300*200 {
+		t.Errorf("len(Pixels(img, 0)) = %d, want %d", len(all), 300*200)
+	}
+
+	sampled := Pixels(img, 100)
+	if len(sampled) == 0 || len(sampled) > 100*50 {
+		t.Errorf("len(Pixels(img, 100)) = %d, want 1 to %d", len(sampled), 100*50)
+	}
+	for _, p := range sampled {
+		if p != 0xFF0000FF {
+			t.Fatalf("Pixels() contains %s, want only #0000FF", p.HexRGB())
+		}
+	}
+}
+
+func TestFromImage(t *testing.T) {
+	blue := imgcolor.RGBA{0, 0, 255, 255}
+	red := imgcolor.RGBA{255, 0, 0, 255}
+	img := splitImage(300, 300, blue, red)
+
+	ranked := SourceColorsFromImage(img)
+	if len(ranked) != 2 || ranked[0] != 0xFF0000FF || ranked[1] != 0xFFFF0000 {
+		t.Errorf("SourceColorsFromImage() = %v, want [#0000FF #FF0000]", ranked)
+	}
+
+	theme := FromImage(img)
+	if theme.Source != 0xFF0000FF {
+		t.Errorf("FromImage().Source = %s, want #0000FF", theme.Source.HexRGB())
+	}
+	if want := schemes.SchemeLightFromSeed(theme.Source); theme.Schemes.Light != want {
+		t.Errorf("FromImage().Schemes.Light doesn't match source")
+	}
+}
+
+func TestFromImageFallback(t *testing.T) {
+	gray := imgcolor.RGBA{128, 128, 128, 255}
+	img := splitImage(64, 64, gray, gray)
+
+	if got := SourceColorFromImage(img); got != score.FallbackColor {
+		t.Errorf("SourceColorFromImage(gray) = %s, want fallback", got.HexRGB())
+	}
+
+	fallback := color.ARGB(0xFF8D3819)
+	opts := WithScoreOptions(score.ScoreOptions{Desired: 1, Fallback: fallback, Filter: true})
+	if got := SourceColorFromImage(img, opts); got != fallback {
+		t.Errorf("SourceColorFromImage(gray) = %s, want %s", got.HexRGB(), fallback.HexRGB())
+	}
+
+	// Unfiltered scoring keeps gray, which is rejected as a seed
+	unfiltered := WithScoreOptions(score.ScoreOptions{Desired: 1})
+	if got := SourceColorFromImage(img, unfiltered, WithFallback(fallback)); got != fallback {
+		t.Errorf("SourceColorFromImage(gray) = %s, want %s", got.HexRGB(), fallback.HexRGB())
+	}
+	if got := FromImage(img, unfiltered); got.Source != score.FallbackColor {
+		t.Errorf("FromImage(gray).Source = %s, want fallback", got.Source.HexRGB())
+	}
+}
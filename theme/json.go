@@ -0,0 +1,187 @@
+package theme
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/palettes"
+	"github.com/Nadim147c/material/schemes"
+)
+
+// roles maps the camelCase names of the color.ARGB fields of a scheme or color
+// group to their numeric ARGB values, like Scheme.toJSON of
+// material-color-utilities.
+type roles map[string]uint32
+
+var argbType = reflect.TypeFor[color.ARGB]()
+
+// camelCase returns the JSON key of a Go field name, e.g. OnPrimary becomes
+// onPrimary.
+func camelCase(field string) string {
+	return strings.ToLower(field[:1]) + field[1:]
+}
+
+// rolesOf returns every color.ARGB field of struct v.
+func rolesOf(v any) roles {
+	val := reflect.ValueOf(v)
+	r := make(roles, val.NumField())
+	for i := range val.NumField() {
+		if f := val.Type().Field(i); f.Type == argbType {
+			r[camelCase(f.Name)] = uint32(val.Field(i).Interface().(color.ARGB))
+		}
+	}
+	return r
+}
+
+// set sets every color.ARGB field of struct pointed by ptr from r.
+func (r roles) set(ptr any) error {
+	val := reflect.ValueOf(ptr).Elem()
+	for i := range val.NumField() {
+		f := val.Type().Field(i)
+		if f.Type != argbType {
+			continue
+		}
+		argb, ok := r[camelCase(f.Name)]
+		if !ok {
+			return fmt.Errorf("missing color %q", camelCase(f.Name))
+		}
+		val.Field(i).Set(reflect.ValueOf(color.ARGB(argb)))
+	}
+	return nil
+}
+
+type jsonPalette struct {
+	Hue      float64 `json:"hue"`
+	Chroma   float64 `json:"chroma"`
+	KeyColor uint32  `json:"keyColor"`
+}
+
+type jsonCustomColor struct {
+	Name  string `json:"name"`
+	Value uint32 `json:"value"`
+	Blend bool   `json:"blend"`
+}
+
+type jsonCustomColorGroup struct {
+	Color jsonCustomColor `json:"color"`
+	Value uint32          `json:"value"`
+	Light roles           `json:"light"`
+	Dark  roles           `json:"dark"`
+}
+
+type jsonTheme struct {
+	Source  uint32 `json:"source"`
+	Schemes struct {
+		Light roles `json:"light"`
+		Dark  roles `json:"dark"`
+	} `json:"schemes"`
+	Palettes struct {
+		Primary        *jsonPalette `json:"primary"`
+		Secondary      *jsonPalette `json:"secondary"`
+		Tertiary       *jsonPalette `json:"tertiary"`
+		Neutral        *jsonPalette `json:"neutral"`
+		NeutralVariant *jsonPalette `json:"neutralVariant"`
+		Error          *jsonPalette `json:"error"`
+	} `json:"palettes"`
+	CustomColors []jsonCustomColorGroup `json:"customColors"`
+}
+
+func encodePalette(tp *palettes.TonalPalette) *jsonPalette {
+	if tp == nil {
+		return nil
+	}
+	return &jsonPalette{tp.Hue, tp.Chroma, uint32(tp.KeyColor.ToARGB())}
+}
+
+func decodePalette(p *jsonPalette) *palettes.TonalPalette {
+	if p == nil {
+		return nil
+	}
+	tp := palettes.FromHueAndChroma(p.Hue, p.Chroma)
+	tp.KeyColor = color.ARGB(p.KeyColor).ToHct()
+	return tp
+}
+
+// MarshalJSON implements json.Marshaler. The layout is a compatible subset of
+// the output of themeFromSourceColor in material-color-utilities: keys are
+// camelCase and colors are numeric 0xAARRGGBB values. Palettes are written as
+// their hue, chroma and keyColor only, not as the full TonalPalette object of
+// material-color-utilities.
+func (t Theme) MarshalJSON() ([]byte, error) {
+	var out jsonTheme
+	out.Source = uint32(t.Source)
+	out.Schemes.Light = rolesOf(t.Schemes.Light)
+	out.Schemes.Dark = rolesOf(t.Schemes.Dark)
+
+	if core := t.Palettes; core != nil {
+		out.Palettes.Primary = encodePalette(core.Primary)
+		out.Palettes.Secondary = encodePalette(core.Secondary)
+		out.Palettes.Tertiary = encodePalette(core.Tertiary)
+		out.Palettes.Neutral = encodePalette(core.Neutral)
+		out.Palettes.NeutralVariant = encodePalette(core.NeutralVariant)
+		out.Palettes.Error = encodePalette(core.Error)
+	}
+
+	out.CustomColors = make([]jsonCustomColorGroup, len(t.CustomColors))
+	for i, g := range t.CustomColors {
+		out.CustomColors[i] = jsonCustomColorGroup{
+			Color: jsonCustomColor{g.Color.Name, uint32(g.Color.Value), g.Color.Blend},
+			Value: uint32(g.Value),
+			Light: rolesOf(g.Light),
+			Dark:  rolesOf(g.Dark),
+		}
+	}
+
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON implements json.Unmarshaler for the layout written by
+// MarshalJSON.
+func (t *Theme) UnmarshalJSON(data []byte) error {
+	var in jsonTheme
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	theme := Theme{Source: color.ARGB(in.Source)}
+	if err := in.Schemes.Light.set(&theme.Schemes.Light); err != nil {
+		return fmt.Errorf("invalid light scheme: %w", err)
+	}
+	if err := in.Schemes.Dark.set(&theme.Schemes.Dark); err != nil {
+		return fmt.Errorf("invalid dark scheme: %w", err)
+	}
+
+	theme.Palettes = &palettes.CorePalette{
+		Primary:        decodePalette(in.Palettes.Primary),
+		Secondary:      decodePalette(in.Palettes.Secondary),
+		Tertiary:       decodePalette(in.Palettes.Tertiary),
+		Neutral:        decodePalette(in.Palettes.Neutral),
+		NeutralVariant: decodePalette(in.Palettes.NeutralVariant),
+		Error:          decodePalette(in.Palettes.Error),
+	}
+
+	theme.CustomColors = make([]schemes.CustomColorGroup, len(in.CustomColors))
+	for i, g := range in.CustomColors {
+		group := schemes.CustomColorGroup{
+			Color: schemes.CustomColor{
+				Name:  g.Color.Name,
+				Value: color.ARGB(g.Color.Value),
+				Blend: g.Color.Blend,
+			},
+			Value: color.ARGB(g.Value),
+		}
+		if err := g.Light.set(&group.Light); err != nil {
+			return fmt.Errorf("invalid custom color %q: %w", g.Color.Name, err)
+		}
+		if err := g.Dark.set(&group.Dark); err != nil {
+			return fmt.Errorf("invalid custom color %q: %w", g.Color.Name, err)
+		}
+		theme.CustomColors[i] = group
+	}
+
+	*t = theme
+	return nil
+}
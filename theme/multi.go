@@ -0,0 +1,70 @@
+package theme
+
+import (
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/num"
+	"github.com/Nadim147c/material/palettes"
+	"github.com/Nadim147c/material/schemes"
+	"github.com/Nadim147c/material/score"
+)
+
+// SeedAssignment controls how multiple seed colors are assigned to the
+// primary, secondary and tertiary palettes of a Theme.
+type SeedAssignment int
+
+const (
+	// SingleSeed derives every palette from the first seed like FromSource.
+	SingleSeed SeedAssignment = iota
+	// AssignByRank assigns the seeds to primary, secondary and tertiary in the
+	// given order.
+	AssignByRank
+	// AssignByHue keeps the first seed as primary and assigns the seed closest
+	// in hue to secondary and the farthest one to tertiary. This keeps the
+	// secondary palette calm and makes the tertiary palette an accent.
+	AssignByHue
+)
+
+// FromSeeds generates a Theme from up to three seeds, which are usually the
+// top colors of score.Score. The first seed is the source of the Theme and
+// always the primary palette. Secondary and tertiary palettes take their hue
+// from the other seeds as selected by assign, and keep the chroma of the
+// baseline palettes. Missing seeds are derived from the first seed like in
+// FromSource. If seeds is empty score.FallbackColor is used.
+func FromSeeds(seeds []color.ARGB, assign SeedAssignment, customColors ...schemes.CustomColor) *Theme {
+	if len(seeds) == 0 {
+		seeds = []color.ARGB{score.FallbackColor}
+	}
+	source := seeds[0]
+	core := palettes.CorePaletteOf(source)
+
+	secondary, tertiary := assignSeeds(seeds, assign)
+	if secondary != 0 {
+		core.Secondary = palettes.FromHueAndChroma(secondary.ToHct().Hue, core.Secondary.Chroma)
+	}
+	if tertiary != 0 {
+		core.Tertiary = palettes.FromHueAndChroma(tertiary.ToHct().Hue, core.Tertiary.Chroma)
+	}
+
+	return fromCorePalette(source, core, customColors)
+}
+
+// assignSeeds returns the secondary and tertiary seeds, or 0 where the
+// palette should be derived from the primary seed.
+func assignSeeds(seeds []color.ARGB, assign SeedAssignment) (color.ARGB, color.ARGB) {
+	rest := seeds[1:min(len(seeds), 3)]
+	switch {
+	case assign == SingleSeed || len(rest) == 0:
+		return 0, 0
+	case len(rest) == 1:
+		return rest[0], 0
+	case assign == AssignByHue:
+		hue := seeds[0].ToHct().Hue
+		a, b := rest[0], rest[1]
+		if num.DifferenceDegrees(hue, b.ToHct().Hue) < num.DifferenceDegrees(hue, a.ToHct().Hue) {
+			a, b = b, a
+		}
+		return a, b
+	default:
+		return rest[0], rest[1]
+	}
+}
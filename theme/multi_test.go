@@ -0,0 +1,69 @@
+package theme
+
+import (
+	imgcolor "image/color"
+	"math"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/num"
+)
+
+func TestFromSeeds(t *testing.T) {
+	blue := color.ARGB(0xFF0000FF)
+	green := color.ARGB(0xFF00FF00)
+	purple := color.ARGB(0xFF8000FF)
+	seeds := []color.ARGB{blue, green, purple}
+
+	sameHue := func(a, b float64) bool {
+		return num.DifferenceDegrees(a, b) < 2
+	}
+
+	single := FromSeeds(seeds, SingleSeed)
+	if single.Schemes != FromSource(blue).Schemes {
+		t.Errorf("FromSeeds(SingleSeed) doesn't match FromSource")
+	}
+
+	byRank := FromSeeds(seeds, AssignByRank)
+	if byRank.Source != blue || byRank.Schemes.Light.Primary != FromSource(blue).Schemes.Light.Primary {
+		t.Errorf("AssignByRank primary doesn't come from the first seed")
+	}
+	if !sameHue(byRank.Palettes.Secondary.Hue, green.ToHct().Hue) ||
+		!sameHue(byRank.Palettes.Tertiary.Hue, purple.ToHct().Hue) {
+		t.Errorf("AssignByRank palettes don't follow seed order")
+	}
+	if byRank.Palettes.Secondary.Chroma != 16 || byRank.Palettes.Tertiary.Chroma != 24 {
+		t.Errorf("AssignByRank chroma = %v, %v, want 16, 24",
+			byRank.Palettes.Secondary.Chroma, byRank.Palettes.Tertiary.Chroma)
+	}
+
+	// Purple is closer to blue, so it becomes secondary
+	byHue := FromSeeds(seeds, AssignByHue)
+	if !sameHue(byHue.Palettes.Secondary.Hue, purple.ToHct().Hue) ||
+		!sameHue(byHue.Palettes.Tertiary.Hue, green.ToHct().Hue) {
+		t.Errorf("AssignByHue secondary hue = %v, tertiary hue = %v",
+			byHue.Palettes.Secondary.Hue, byHue.Palettes.Tertiary.Hue)
+	}
+
+	// Missing seeds fall back to the baseline palettes
+	two := FromSeeds(seeds[:2], AssignByRank)
+	if math.Abs(two.Palettes.Tertiary.Hue-FromSource(blue).Palettes.Tertiary.Hue) > 1e-9 {
+		t.Errorf("tertiary hue = %v, want derived from primary", two.Palettes.Tertiary.Hue)
+	}
+	if got := FromSeeds(nil, AssignByRank).Source; got == 0 {
+		t.Errorf("FromSeeds(nil) has no source")
+	}
+}
+
+func TestFromImageSeeds(t *testing.T) {
+	img := splitImage(300, 300, imgcolor.RGBA{0, 0, 255, 255}, imgcolor.RGBA{255, 0, 0, 255})
+
+	theme := FromImage(img, WithSeedAssignment(AssignByRank))
+	if theme.Source != 0xFF0000FF {
+		t.Errorf("FromImage().Source = %s, want #0000FF", theme.Source.HexRGB())
+	}
+	red := color.ARGB(0xFFFF0000).ToHct().Hue
+	if num.DifferenceDegrees(theme.Palettes.Secondary.Hue, red) > 1 {
+		t.Errorf("secondary hue = %v, want %v", theme.Palettes.Secondary.Hue, red)
+	}
+}
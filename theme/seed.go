@@ -0,0 +1,38 @@
+package theme
+
+import (
+	"errors"
+
+	"github.com/Nadim147c/material/color"
+)
+
+// minSeedChroma is the chroma below which the hue of a seed is not reliable
+// enough to build palettes from. It matches the grayscale cutoff of
+// score.Score.
+const minSeedChroma = 5.0
+
+// Errors returned by ValidateSeed.
+var (
+	ErrSeedTooDark  = errors.New("seed is too close to black")
+	ErrSeedTooLight = errors.New("seed is too close to white")
+	ErrSeedGray     = errors.New("seed is too close to gray")
+)
+
+// ValidateSeed reports whether seed can produce a usable palette. Palettes
+// are built from the hue and chroma of the seed, so seeds that are nearly
+// achromatic produce washed out themes with an arbitrary hue. ValidateSeed
+// returns ErrSeedTooDark, ErrSeedTooLight or ErrSeedGray for such seeds and nil
+// otherwise.
+func ValidateSeed(seed color.ARGB) error {
+	hct := seed.ToHct()
+	switch {
+	case hct.Chroma >= minSeedChroma:
+		return nil
+	case hct.Tone < 10:
+		return ErrSeedTooDark
+	case hct.Tone > 90:
+		return ErrSeedTooLight
+	default:
+		return ErrSeedGray
+	}
+}
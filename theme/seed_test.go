@@ -0,0 +1,30 @@
+package theme
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+)
+
+func TestValidateSeed(t *testing.T) {
+	tests := []struct {
+		seed color.ARGB
+		want error
+	}{
+		{0xFF4285F4, nil},
+		{0xFF6750A4, nil},
+		{0xFF000000, ErrSeedTooDark},
+		{0xFF0A0A0B, ErrSeedTooDark},
+		{0xFFFFFFFF, ErrSeedTooLight},
+		{0xFFF8F8F6, ErrSeedTooLight},
+		{0xFF808080, ErrSeedGray},
+		{0xFF7A7C80, ErrSeedGray},
+	}
+
+	for _, tt := range tests {
+		if got := ValidateSeed(tt.seed); !errors.Is(got, tt.want) {
+			t.Errorf("ValidateSeed(%s) = %v, want %v", tt.seed.HexRGB(), got, tt.want)
+		}
+	}
+}
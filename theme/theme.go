@@ -0,0 +1,54 @@
+// Package theme generates complete Material themes from a source color or an
+// image.
+package theme
+
+import (
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/palettes"
+	"github.com/Nadim147c/material/schemes"
+)
+
+// Schemes holds the light and dark scheme of a Theme.
+type Schemes struct {
+	Light schemes.Scheme `json:"light" yaml:"light" toml:"light"`
+	Dark  schemes.Scheme `json:"dark"  yaml:"dark"  toml:"dark"`
+}
+
+// Theme is a Material theme generated from a single source color. It contains
+// the baseline light and dark schemes, the core palettes they are built from
+// and custom colors harmonized with the source.
+//
+// Theme is encoded to JSON in a layout compatible with themeFromSourceColor of
+// material-color-utilities, see MarshalJSON.
+type Theme struct {
+	// Source is the color the theme is generated from
+	Source       color.ARGB                 `yaml:"source"        toml:"source"`
+	Schemes      Schemes                    `yaml:"schemes"       toml:"schemes"`
+	Palettes     *palettes.CorePalette      `yaml:"palettes"      toml:"palettes"`
+	CustomColors []schemes.CustomColorGroup `yaml:"custom_colors" toml:"custom_colors"`
+}
+
+// FromSource generates a Theme from source. Custom colors are resolved with
+// schemes.NewCustomColorGroup.
+func FromSource(source color.ARGB, customColors ...schemes.CustomColor) *Theme {
+	return fromCorePalette(source, palettes.CorePaletteOf(source), customColors)
+}
+
+// fromCorePalette generates a Theme from the palettes of core. Custom colors
+// are harmonized with source.
+func fromCorePalette(source color.ARGB, core *palettes.CorePalette, customColors []schemes.CustomColor) *Theme {
+	groups := make([]schemes.CustomColorGroup, len(customColors))
+	for i, c := range customColors {
+		groups[i] = schemes.NewCustomColorGroup(source, c)
+	}
+
+	return &Theme{
+		Source: source,
+		Schemes: Schemes{
+			Light: schemes.LightSchemeFromCorePalette(core),
+			Dark:  schemes.DarkSchemeFromCorePalette(core),
+		},
+		Palettes:     core,
+		CustomColors: groups,
+	}
+}
@@ -0,0 +1,104 @@
+package theme
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"slices"
+	"testing"
+
+	"github.com/Nadim147c/material/color"
+	"github.com/Nadim147c/material/schemes"
+)
+
+func TestFromSource(t *testing.T) {
+	source := color.ARGB(0xFF6750A4)
+	brand := schemes.CustomColor{Name: "brand", Value: 0xFFFF0000, Blend: true}
+	theme := FromSource(source, brand)
+
+	if theme.Schemes.Light.Primary != 0xFF6750A4 {
+		t.Errorf("light primary = %s, want #6750A4", theme.Schemes.Light.Primary.HexRGB())
+	}
+	if theme.Schemes.Dark.Primary != 0xFFCFBCFF {
+		t.Errorf("dark primary = %s, want #CFBCFF", theme.Schemes.Dark.Primary.HexRGB())
+	}
+	if got := theme.Palettes.Primary.Tone(40); got != theme.Schemes.Light.Primary {
+		t.Errorf("primary palette tone 40 = %s, want %s", got.HexRGB(), theme.Schemes.Light.Primary.HexRGB())
+	}
+	if len(theme.CustomColors) != 1 || theme.CustomColors[0] != schemes.NewCustomColorGroup(source, brand) {
+		t.Errorf("CustomColors = %v, want brand group", theme.CustomColors)
+	}
+}
+
+func TestThemeJSON(t *testing.T) {
+	theme := FromSource(0xFF6750A4, schemes.CustomColor{Name: "brand", Value: 0xFFFF0000, Blend: true})
+
+	data, err := json.Marshal(theme)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	// Compatible subset of the themeFromSourceColor layout of
+	// material-color-utilities
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if raw["source"] != float64(0xFF6750A4) {
+		t.Errorf("source = %v, want %v", raw["source"], uint32(0xFF6750A4))
+	}
+	light := raw["schemes"].(map[string]any)["light"].(map[string]any)
+	if len(light) != 29 || light["onPrimaryContainer"] == nil || light["inverseOnSurface"] == nil {
+		t.Errorf("schemes.light = %v, want 29 camelCase roles", light)
+	}
+	pals := raw["palettes"].(map[string]any)
+	for _, key := range []string{"primary", "secondary", "tertiary", "neutral", "neutralVariant", "error"} {
+		if pals[key] == nil {
+			t.Errorf("palettes.%s is missing", key)
+		}
+	}
+	custom := raw["customColors"].([]any)[0].(map[string]any)
+	if custom["light"].(map[string]any)["onColorContainer"] == nil {
+		t.Errorf("customColors[0].light = %v, want camelCase roles", custom["light"])
+	}
+
+	var decoded Theme
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Theme.UnmarshalJSON() error = %v", err)
+	}
+	if decoded.Source != theme.Source || decoded.Schemes != theme.Schemes ||
+		!slices.Equal(decoded.CustomColors, theme.CustomColors) {
+		t.Errorf("decoded theme = %+v, want %+v", decoded, theme)
+	}
+	if got, want := decoded.Palettes.Tertiary.Tone(80), theme.Palettes.Tertiary.Tone(80); got != want {
+		t.Errorf("decoded tertiary tone 80 = %s, want %s", got.HexRGB(), want.HexRGB())
+	}
+
+	if err := json.Unmarshal([]byte(`{"schemes": {"light": {}}}`), &decoded); err == nil {
+		t.Errorf("Theme.UnmarshalJSON() with missing roles succeeded")
+	}
+}
+
+func TestThemeTextRoundTrip(t *testing.T) {
+	theme := FromSource(0xFF6750A4, schemes.CustomColor{Name: "brand", Value: 0xFFFF0000, Blend: true})
+
+	// encoding/xml encodes colors with MarshalText and palettes field by
+	// field, as YAML and TOML encoders do
+	data, err := xml.Marshal(theme)
+	if err != nil {
+		t.Fatalf("xml.Marshal() error = %v", err)
+	}
+
+	// Decode into a theme whose palettes already cached other tones
+	decoded := FromSource(0xFF00897B)
+	decoded.Palettes.Tertiary.PrecomputeCommonTones()
+	if err := xml.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+	if decoded.Source != theme.Source || decoded.Schemes != theme.Schemes ||
+		!slices.Equal(decoded.CustomColors, theme.CustomColors) {
+		t.Errorf("decoded theme = %+v, want %+v", decoded, theme)
+	}
+	if got, want := decoded.Palettes.Tertiary.Tone(80), theme.Palettes.Tertiary.Tone(80); got != want {
+		t.Errorf("decoded tertiary tone 80 = %s, want %s", got.HexRGB(), want.HexRGB())
+	}
+}